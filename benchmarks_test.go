@@ -182,6 +182,104 @@ func BenchmarkMergeStructs_NewInstance_Parallel(b *testing.B) {
 	})
 }
 
+func BenchmarkNewStruct_AcquireInstance(b *testing.B) {
+	dStruct := newBenchmarkBuilder().Build()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = dStruct.Release(dStruct.Acquire())
+	}
+}
+
+func BenchmarkNewStruct_AcquireInstance_Parallel(b *testing.B) {
+	dStruct := newBenchmarkBuilder().Build()
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = dStruct.Release(dStruct.Acquire())
+		}
+	})
+}
+
+func BenchmarkNewStruct_Build(b *testing.B) {
+	builder := newBenchmarkBuilder()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		builder.Build()
+	}
+}
+
+func BenchmarkNewStruct_Build_Cached(b *testing.B) {
+	builder := newBenchmarkBuilder().UseCache(NewTypeCache(0))
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		builder.Build()
+	}
+}
+
+func BenchmarkNewStruct_Build_Cached_Parallel(b *testing.B) {
+	cache := NewTypeCache(0)
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		builder := newBenchmarkBuilder().UseCache(cache)
+		for pb.Next() {
+			builder.Build()
+		}
+	})
+}
+
+func newBenchmarkBuilder() Builder {
+	integer := 0
+	uinteger := uint(0)
+	str := ""
+	float := 0.0
+	boolean := false
+
+	return NewStruct().
+		AddField("Integer", integer, "").
+		AddField("String", str, "").
+		AddField("Uinteger", uinteger, "").
+		AddField("Float", float, "").
+		AddField("Bool", boolean, "").
+		AddField("Time", time.Time{}, "").
+		AddField("PointerString", &str, "").
+		AddField("PointerInteger", &integer, "").
+		AddField("PointerUinteger", &uinteger, "").
+		AddField("PointerFloat", &float, "").
+		AddField("PointerBool", &boolean, "").
+		AddField("PointerTime", &time.Time{}, "").
+		AddField("Integers", []int{}, "")
+}
+
 func newInstance() benchmarkStruct {
 	return benchmarkStruct{}
 }
+
+func BenchmarkNewStruct_Build_Nested(b *testing.B) {
+	builder := NewStruct()
+	defineBenchmarkNested(builder, 12)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = builder.Build()
+	}
+}
+
+func defineBenchmarkNested(builder Builder, depth int) {
+	builder.AddField("Value", 0, "")
+	if depth > 0 {
+		builder.AddStructField("Nested", func(nested Builder) {
+			defineBenchmarkNested(nested, depth-1)
+		}, "")
+	}
+}
@@ -1,6 +1,12 @@
 package dynamicstruct
 
-import "reflect"
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"reflect"
+	"sync"
+)
 
 type (
 	// Builder holds all fields' definitions for desired structs.
@@ -11,17 +17,107 @@ type (
 		// AddField creates new struct's field.
 		// It expects field's name, type and string.
 		// Type is provided as an instance of some golang type.
+		// Instance of interface type provides its concrete type,
+		// so interface fields are added with AddInterfaceField,
+		// and fields of type described by reflect.Type with AddFieldType.
 		// Tag is provided as classical golang field tag.
 		//
 		// builder.AddField("SomeFloatField", 0.0, `json:"boolean" validate:"gte=10"`)
 		//
 		AddField(name string, typ interface{}, tag string) Builder
+		// AddInterfaceField creates new struct's field of interface type.
+		// Type is provided as nil pointer to interface, or as reflect.Type
+		// of interface. Field can hold any value which implements interface.
+		// Other types make Build and BuildE fail.
+		//
+		// builder.AddInterfaceField("SomeReader", (*io.Reader)(nil), "")
+		//
+		AddInterfaceField(name string, typ interface{}, tag string) Builder
+		// AddStructField creates new struct's field whose type is nested
+		// struct. Nested struct's fields are defined by define function,
+		// on Builder which is later available through field's Builder
+		// method. Nested struct is built together with its parent.
+		// Nested Builder doesn't inherit parent's cache set by UseCache,
+		// nor rules set by GenerateTags, so they are set on it separately.
+		//
+		// builder.AddStructField("SomeStruct", func(nested dynamicstruct.Builder) {
+		// 	nested.AddField("SomeIntField", 0, `json:"int"`)
+		// }, `json:"struct"`)
+		//
+		AddStructField(name string, define func(builder Builder), tag string) Builder
+		// AddSliceOfStructsField creates new struct's field whose type
+		// is slice of nested struct, defined as for AddStructField.
+		//
+		// builder.AddSliceOfStructsField("SomeStructs", func(nested dynamicstruct.Builder) {
+		// 	nested.AddField("SomeIntField", 0, `json:"int"`)
+		// }, `json:"structs"`)
+		//
+		AddSliceOfStructsField(name string, define func(builder Builder), tag string) Builder
+		// AddMapOfStructsField creates new struct's field whose type is map
+		// of nested struct, defined as for AddStructField. Key's type is
+		// provided as an instance of some golang type, or as reflect.Type.
+		// Key's type which is not comparable makes Build and BuildE fail.
+		//
+		// builder.AddMapOfStructsField("SomeStructs", "", func(nested dynamicstruct.Builder) {
+		// 	nested.AddField("SomeIntField", 0, `json:"int"`)
+		// }, `json:"structs"`)
+		//
+		AddMapOfStructsField(name string, key interface{}, define func(builder Builder), tag string) Builder
+		// AddFieldType creates new struct's field, same as AddField,
+		// but with explicitly declared type, without a sample value.
+		//
+		// builder.AddFieldType("SomeReader", dynamicstruct.TypeOf[io.Reader](), "")
+		//
+		AddFieldType(name string, typ reflect.Type, tag string) Builder
+		// AddFields creates new struct's fields from theirs definitions.
+		//
+		// builder.AddFields(
+		// 	dynamicstruct.FieldOf[int]("SomeInt", `json:"int"`),
+		// 	dynamicstruct.FieldOf[*string]("SomeStringPointer", `json:"string"`),
+		// )
+		//
+		AddFields(fields ...FieldDefinition) Builder
 		// RemoveField removes existing struct's field.
 		//
 		// builder.RemoveField("SomeFloatField")
 		//
 		RemoveField(name string) Builder
+		// InsertFieldBefore creates new struct's field, same as AddField,
+		// and places it right before existing field with mark name.
+		// If there is no such field, new one is placed at the end.
+		//
+		// builder.InsertFieldBefore("SomeFloatField", "SomeIntField", 0, `json:"int"`)
+		//
+		InsertFieldBefore(mark string, name string, typ interface{}, tag string) Builder
+		// InsertFieldAfter creates new struct's field, same as AddField,
+		// and places it right after existing field with mark name.
+		// If there is no such field, new one is placed at the end.
+		//
+		// builder.InsertFieldAfter("SomeFloatField", "SomeIntField", 0, `json:"int"`)
+		//
+		InsertFieldAfter(mark string, name string, typ interface{}, tag string) Builder
+		// MoveField moves existing struct's field to desired position.
+		// Index out of range moves field to the beginning or to the end.
+		//
+		// builder.MoveField("SomeFloatField", 0)
+		//
+		MoveField(name string, index int) Builder
+		// RenameField changes name of existing struct's field,
+		// keeping its position, type and tag.
+		//
+		// builder.RenameField("SomeFloatField", "OtherFloatField")
+		//
+		RenameField(name string, newName string) Builder
+		// ReorderFields places fields with provided names at the beginning,
+		// in the same order as names are listed. All other fields follow them,
+		// keeping theirs current order. Unknown names are ignored.
+		//
+		// builder.ReorderFields("SomeIntField", "SomeFloatField")
+		//
+		ReorderFields(names ...string) Builder
 		// HasField checks if struct has a field with a given name.
+		// Name can be a path to field of nested struct, as it is
+		// described for GetField.
 		//
 		// if builder.HasField("SomeFloatField") { ...
 		//
@@ -29,27 +125,136 @@ type (
 		// GetField returns struct's field definition.
 		// If there is no such field, it returns nil.
 		// Usable to edit existing struct's field.
+		// Fields of nested structs, like ones expanded by
+		// MergeStructsWithOptions, are reached by dotted path.
 		//
 		// field := builder.GetField("SomeFloatField")
+		// nested := builder.GetField("SomeEmbedded.SomeIntField")
 		//
 		GetField(name string) FieldConfig
+		// Fields returns definitions of all struct's fields,
+		// in the same order as they are defined in struct.
+		// Returned definitions can be used to edit fields.
+		//
+		// for _, field := range builder.Fields() { ...
+		//
+		Fields() []FieldConfig
+		// MapTags replaces tags of all struct's fields with ones returned
+		// by mapper. Mapper gets field's name and its current tags.
+		// Fields whose tags are not valid are left unchanged.
+		//
+		// builder.MapTags(func(name string, tags dynamicstruct.Tags) dynamicstruct.Tags {
+		// 	tags.Set("db", strings.ToLower(name))
+		// 	return tags
+		// })
+		//
+		MapTags(mapper func(name string, tags Tags) Tags) Builder
+		// GenerateTags sets rules for generating field tags' keys from
+		// fields' names. Rules are applied by Build and BuildE, to all
+		// exported and not embedded fields, which don't have tag's key
+		// already. Rule replaces previously set rule with the same key.
+		// Rules are not applied to fields of nested Builders.
+		//
+		// builder.GenerateTags(dynamicstruct.TagRule{
+		// 	Key:       "json",
+		// 	Strategy:  dynamicstruct.SnakeCase,
+		// 	OmitEmpty: true,
+		// })
+		//
+		GenerateTags(rules ...TagRule) Builder
 		// Build returns definition for dynamic struct.
 		// Definition can be used to create new instances.
 		//
 		// dStruct := builder.Build()
 		//
 		Build() DynamicStruct
+		// UseCache sets TypeCache which is used by Build and BuildE.
+		// Builders with identical fields' definitions, which use the same
+		// cache, get the same DynamicStruct. Nil value disables caching.
+		// Cache is not used by nested Builders.
+		//
+		// builder.UseCache(dynamicstruct.DefaultTypeCache)
+		//
+		UseCache(cache *TypeCache) Builder
+		// BuildE returns definition for dynamic struct, same as Build.
+		// Instead of panicking, it validates all fields first and returns
+		// an error of type *BuildError which lists every invalid field.
+		//
+		// dStruct, err := builder.BuildE()
+		//
+		BuildE() (DynamicStruct, error)
+		// Clone returns independent deep copy of Builder, including
+		// nested Builders. Clone is always mutable, and changes in it
+		// don't affect original Builder, nor vice versa.
+		//
+		// variant := builder.Clone().AddField("SomeNewField", 0, "")
+		//
+		Clone() Builder
+		// Immutable returns copy-on-write copy of Builder. Each method of
+		// immutable Builder, which would change Builder, leaves it as it is
+		// and returns new immutable Builder with applied change. Fields'
+		// definitions returned by GetField and Fields are copies, so editing
+		// them doesn't change Builder. Immutable Builder can be safely
+		// shared between goroutines.
+		//
+		// base := builder.Immutable()
+		// variant := base.AddField("SomeNewField", 0, "")
+		//
+		Immutable() Builder
 	}
 
 	// FieldConfig holds single field's definition.
-	// It provides possibility to edit field's type and tag.
+	// It provides possibility to read field's definition,
+	// and to edit field's type and tag.
 	FieldConfig interface {
+		// Name returns field's name.
+		//
+		// name := field.Name()
+		//
+		Name() string
+		// Type returns field's type. If type is defined by
+		// nested struct which can't be built, it returns nil.
+		//
+		// typ := field.Type()
+		//
+		Type() reflect.Type
+		// Tag returns field's tag, as it is set to field.
+		// It doesn't contain keys generated by Builder's GenerateTags.
+		//
+		// tag := field.Tag()
+		//
+		Tag() string
+		// Anonymous checks if field is embedded.
+		//
+		// if field.Anonymous() { ...
+		//
+		Anonymous() bool
+		// PkgPath returns package path of unexported field.
+		// For exported fields, it returns empty string.
+		//
+		// pkg := field.PkgPath()
+		//
+		PkgPath() string
+		// Builder returns Builder of nested struct, which defines field's
+		// type, for fields added by AddStructField, AddSliceOfStructsField
+		// and AddMapOfStructsField, or expanded by MergeStructsWithOptions.
+		// For all other fields, it returns nil.
+		//
+		// field.Builder().AddField("SomeNestedField", "", "")
+		//
+		Builder() Builder
 		// SetType changes field's type.
 		// Expected value is an instance of golang type.
 		//
 		// field.SetType([]int{})
 		//
 		SetType(typ interface{}) FieldConfig
+		// SetTypeOf changes field's type, same as SetType,
+		// but with explicitly declared type, without a sample value.
+		//
+		// field.SetTypeOf(dynamicstruct.TypeOf[io.Reader]())
+		//
+		SetTypeOf(typ reflect.Type) FieldConfig
 		// SetTag changes fields's tag.
 		// Expected value is an string which represents classical
 		// golang tag.
@@ -57,6 +262,26 @@ type (
 		// field.SetTag(`json:"slice"`)
 		//
 		SetTag(tag string) FieldConfig
+		// GetTag returns value of field tag's key.
+		// If there is no such key, it returns empty string.
+		//
+		// value := field.GetTag("json")
+		//
+		GetTag(key string) string
+		// SetTagKey changes value of field tag's key, or adds it
+		// at the end of tag, keeping all other keys as they are.
+		// If current tag or key is not valid, tag is left unchanged.
+		//
+		// field.SetTagKey("json", "slice,omitempty")
+		//
+		SetTagKey(key string, value string) FieldConfig
+		// RemoveTagKey removes key from field's tag,
+		// keeping all other keys as they are.
+		// If current tag is not valid, it is left unchanged.
+		//
+		// field.RemoveTagKey("json")
+		//
+		RemoveTagKey(key string) FieldConfig
 	}
 
 	// DynamicStruct contains defined dynamic struct.
@@ -80,10 +305,72 @@ type (
 		// value := dStruct.NewMapOfStructs("")
 		//
 		NewMapOfStructs(key interface{}) interface{}
+
+		// Acquire provides instance of defined dynamic struct, same as New,
+		// but reuses instances previously returned by Release when possible.
+		// Acquired instances are always zeroed.
+		//
+		// value := dStruct.Acquire()
+		// defer dStruct.Release(value)
+		//
+		Acquire() interface{}
+
+		// Release zeroes instance of defined dynamic struct and returns it
+		// for reuse by Acquire. Instance must not be used after release.
+		// It returns an error if value is not a pointer to this dynamic struct.
+		//
+		// err := dStruct.Release(value)
+		//
+		Release(value interface{}) error
+
+		// Type returns reflect.Type of defined dynamic struct.
+		//
+		// typ := dStruct.Type()
+		//
+		Type() reflect.Type
+
+		// Fields returns all fields of defined dynamic struct,
+		// in the same order as they are defined.
+		//
+		// fields := dStruct.Fields()
+		//
+		Fields() []reflect.StructField
+
+		// Size returns number of bytes needed to store instance
+		// of defined dynamic struct.
+		//
+		// size := dStruct.Size()
+		//
+		Size() uintptr
+
+		// Align returns alignment in bytes of defined dynamic struct.
+		//
+		// align := dStruct.Align()
+		//
+		Align() int
+
+		// ToBuilder returns new instance of Builder interface, with
+		// all fields' definitions of defined dynamic struct.
+		// Changes in Builder don't affect dynamic struct.
+		//
+		// builder := dStruct.ToBuilder().AddField("SomeNewField", 0, "")
+		//
+		ToBuilder() Builder
+
+		// GoSource renders definition of dynamic struct as formatted golang
+		// type declaration with desired type's name. Packages of all
+		// referenced named types, like time, must be imported by the file
+		// which contains the declaration.
+		//
+		// source, err := dStruct.GoSource("MyStruct")
+		//
+		GoSource(name string) (string, error)
 	}
 
 	builderImpl struct {
-		fields []*fieldConfigImpl
+		fields   []*fieldConfigImpl
+		cache    *TypeCache
+		tagRules []TagRule
 	}
 
 	fieldConfigImpl struct {
@@ -94,8 +381,15 @@ type (
 		anonymous bool
 	}
 
+	// declaredType is field's type declared by reflect.Type,
+	// instead of by an instance of golang type.
+	declaredType struct {
+		typ reflect.Type
+	}
+
 	dynamicStructImpl struct {
 		definition reflect.Type
+		pool       sync.Pool
 	}
 )
 
@@ -119,23 +413,36 @@ func ExtendStruct(value interface{}) Builder {
 	return MergeStructs(value)
 }
 
+// ExtendStructType extends existing struct's type and
+// returns new instance of Builder interface, without need
+// for struct's instance. Pointer to struct's type is accepted too.
+// It returns an error if type is not a struct.
+//
+// builder, err := dynamicstruct.ExtendStructType(reflect.TypeOf(MyStruct{}))
+//
+func ExtendStructType(typ reflect.Type) (Builder, error) {
+	return MergeStructsWithOptions(MergeOptions{}, typ)
+}
+
+// ExtendType extends existing struct's type, provided as type parameter,
+// and returns new instance of Builder interface, same as ExtendStructType.
+//
+// builder, err := dynamicstruct.ExtendType[MyStruct]()
+//
+func ExtendType[T any]() (Builder, error) {
+	return ExtendStructType(reflect.TypeOf((*T)(nil)).Elem())
+}
+
 // MergeStructs merges a list of existing instances of structs and
-// returns new instance of Builder interface.
+// returns new instance of Builder interface. Values can be
+// provided as it is described for MergeStructsWithOptions.
 //
 // builder := dynamicstruct.MergeStructs(MyStructOne{}, MyStructTwo{}, MyStructThree{})
 //
 func MergeStructs(values ...interface{}) Builder {
-	builder := NewStruct()
-
-	for _, value := range values {
-		valueOf := reflect.Indirect(reflect.ValueOf(value))
-		typeOf := valueOf.Type()
-
-		for i := 0; i < valueOf.NumField(); i++ {
-			fval := valueOf.Field(i)
-			ftyp := typeOf.Field(i)
-			builder.(*builderImpl).addField(ftyp.Name, ftyp.PkgPath, fval.Interface(), string(ftyp.Tag), ftyp.Anonymous)
-		}
+	builder, err := MergeStructsWithOptions(MergeOptions{}, values...)
+	if err != nil {
+		panic(err)
 	}
 
 	return builder
@@ -145,9 +452,14 @@ func (b *builderImpl) AddField(name string, typ interface{}, tag string) Builder
 	return b.addField(name, "", typ, tag, false)
 }
 
+func (b *builderImpl) AddInterfaceField(name string, typ interface{}, tag string) Builder {
+	return b.AddField(name, interfaceType(typ), tag)
+}
+
 func (b *builderImpl) addField(name string, pkg string, typ interface{}, tag string, anonymous bool) Builder {
 	b.fields = append(b.fields, &fieldConfigImpl{
 		name:      name,
+		pkg:       pkg,
 		typ:       typ,
 		tag:       tag,
 		anonymous: anonymous,
@@ -166,42 +478,273 @@ func (b *builderImpl) RemoveField(name string) Builder {
 	return b
 }
 
-func (b *builderImpl) HasField(name string) bool {
-	for i := range b.fields {
-		if b.fields[i].name == name {
-			return true
+func (b *builderImpl) InsertFieldBefore(mark string, name string, typ interface{}, tag string) Builder {
+	return b.insertField(b.fieldIndex(mark), name, typ, tag)
+}
+
+func (b *builderImpl) InsertFieldAfter(mark string, name string, typ interface{}, tag string) Builder {
+	index := b.fieldIndex(mark)
+	if index >= 0 {
+		index++
+	}
+
+	return b.insertField(index, name, typ, tag)
+}
+
+func (b *builderImpl) insertField(index int, name string, typ interface{}, tag string) Builder {
+	if index < 0 {
+		return b.AddField(name, typ, tag)
+	}
+
+	b.fields = append(b.fields, nil)
+	copy(b.fields[index+1:], b.fields[index:])
+	b.fields[index] = &fieldConfigImpl{
+		name: name,
+		typ:  typ,
+		tag:  tag,
+	}
+
+	return b
+}
+
+func (b *builderImpl) MoveField(name string, index int) Builder {
+	current := b.fieldIndex(name)
+	if current < 0 {
+		return b
+	}
+
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(b.fields) {
+		index = len(b.fields) - 1
+	}
+
+	field := b.fields[current]
+	if current < index {
+		copy(b.fields[current:index], b.fields[current+1:index+1])
+	} else {
+		copy(b.fields[index+1:current+1], b.fields[index:current])
+	}
+	b.fields[index] = field
+
+	return b
+}
+
+func (b *builderImpl) RenameField(name string, newName string) Builder {
+	index := b.fieldIndex(name)
+	if index < 0 {
+		return b
+	}
+
+	b.fields[index].name = newName
+	if token.IsExported(newName) {
+		b.fields[index].pkg = ""
+	}
+
+	return b
+}
+
+func (b *builderImpl) ReorderFields(names ...string) Builder {
+	fields := make([]*fieldConfigImpl, 0, len(b.fields))
+	moved := make(map[*fieldConfigImpl]bool, len(names))
+
+	for _, name := range names {
+		for _, field := range b.fields {
+			if field.name == name && !moved[field] {
+				fields = append(fields, field)
+				moved[field] = true
+				break
+			}
+		}
+	}
+
+	for _, field := range b.fields {
+		if !moved[field] {
+			fields = append(fields, field)
 		}
 	}
-	return false
+
+	b.fields = fields
+	return b
 }
 
-func (b *builderImpl) GetField(name string) FieldConfig {
+func (b *builderImpl) fieldIndex(name string) int {
 	for i := range b.fields {
 		if b.fields[i].name == name {
-			return b.fields[i]
+			return i
 		}
 	}
+	return -1
+}
+
+func (b *builderImpl) HasField(name string) bool {
+	return b.getFieldByPath(name) != nil
+}
+
+func (b *builderImpl) GetField(name string) FieldConfig {
+	if field := b.getFieldByPath(name); field != nil {
+		return field
+	}
 	return nil
 }
 
-func (b *builderImpl) Build() DynamicStruct {
-	var structFields []reflect.StructField
+func (b *builderImpl) Fields() []FieldConfig {
+	fields := make([]FieldConfig, 0, len(b.fields))
+	for _, field := range b.fields {
+		fields = append(fields, field)
+	}
+	return fields
+}
 
+func (b *builderImpl) MapTags(mapper func(name string, tags Tags) Tags) Builder {
 	for _, field := range b.fields {
+		tags, err := ParseTags(field.tag)
+		if err != nil {
+			continue
+		}
+		field.tag = mapper(field.name, tags).String()
+	}
+	return b
+}
+
+func (b *builderImpl) UseCache(cache *TypeCache) Builder {
+	b.cache = cache
+	return b
+}
+
+func (b *builderImpl) Build() DynamicStruct {
+	dynamicStruct, err := b.BuildE()
+	if err != nil {
+		panic(err)
+	}
+
+	return dynamicStruct
+}
+
+func (b *builderImpl) BuildE() (dynamicStruct DynamicStruct, err error) {
+	types, err := b.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	structFields := make([]reflect.StructField, 0, len(b.fields))
+
+	for i, field := range b.fields {
 		structFields = append(structFields, reflect.StructField{
 			Name:      field.name,
 			PkgPath:   field.pkg,
-			Type:      reflect.TypeOf(field.typ),
-			Tag:       reflect.StructTag(field.tag),
+			Type:      types[i],
+			Tag:       reflect.StructTag(b.fieldTag(field)),
 			Anonymous: field.anonymous,
 		})
 	}
 
+	defer func() {
+		if r := recover(); r != nil {
+			dynamicStruct = nil
+			err = &BuildError{
+				Reason: fmt.Sprint(r),
+			}
+		}
+	}()
+
+	if b.cache != nil {
+		return b.cache.build(structFields), nil
+	}
+
 	return &dynamicStructImpl{
 		definition: reflect.StructOf(structFields),
+	}, nil
+}
+
+func (b *builderImpl) Clone() Builder {
+	return b.clone()
+}
+
+func (b *builderImpl) Immutable() Builder {
+	return &immutableBuilder{
+		builder: b.clone(),
+	}
+}
+
+func (b *builderImpl) clone() *builderImpl {
+	fields := make([]*fieldConfigImpl, 0, len(b.fields))
+	for _, field := range b.fields {
+		fields = append(fields, field.clone())
+	}
+
+	return &builderImpl{
+		fields:   fields,
+		cache:    b.cache,
+		tagRules: append([]TagRule(nil), b.tagRules...),
+	}
+}
+
+func unwrapBuilder(builder Builder) (*builderImpl, bool) {
+	switch builder := builder.(type) {
+	case *builderImpl:
+		return builder, true
+	case *immutableBuilder:
+		return builder.builder, true
+	case *syncBuilder:
+		return builder.Clone().(*builderImpl), true
+	default:
+		return nil, false
 	}
 }
 
+func (f *fieldConfigImpl) clone() *fieldConfigImpl {
+	field := *f
+	if nested, ok := f.typ.(*nestedStruct); ok {
+		field.typ = &nestedStruct{
+			builder: nested.builder.clone(),
+			kind:    nested.kind,
+			key:     nested.key,
+		}
+	}
+	return &field
+}
+
+func (f *fieldConfigImpl) reflectType() reflect.Type {
+	switch typ := f.typ.(type) {
+	case declaredType:
+		return typ.typ
+	case *nestedStruct:
+		return typ.reflectType()
+	case invalidType:
+		return nil
+	}
+	return reflect.TypeOf(f.typ)
+}
+
+func (f *fieldConfigImpl) Name() string {
+	return f.name
+}
+
+func (f *fieldConfigImpl) Type() reflect.Type {
+	return f.reflectType()
+}
+
+func (f *fieldConfigImpl) Tag() string {
+	return f.tag
+}
+
+func (f *fieldConfigImpl) Anonymous() bool {
+	return f.anonymous
+}
+
+func (f *fieldConfigImpl) PkgPath() string {
+	return f.pkg
+}
+
+func (f *fieldConfigImpl) Builder() Builder {
+	if nested, ok := f.typ.(*nestedStruct); ok {
+		return nested.builder
+	}
+	return nil
+}
+
 func (f *fieldConfigImpl) SetType(typ interface{}) FieldConfig {
 	f.typ = typ
 	return f
@@ -212,10 +755,90 @@ func (f *fieldConfigImpl) SetTag(tag string) FieldConfig {
 	return f
 }
 
+func (f *fieldConfigImpl) GetTag(key string) string {
+	return reflect.StructTag(f.tag).Get(key)
+}
+
+func (f *fieldConfigImpl) SetTagKey(key string, value string) FieldConfig {
+	tags, err := ParseTags(f.tag)
+	if err != nil || !isTagKey(key) {
+		return f
+	}
+
+	tags.Set(key, value)
+	f.tag = tags.String()
+
+	return f
+}
+
+func (f *fieldConfigImpl) RemoveTagKey(key string) FieldConfig {
+	tags, err := ParseTags(f.tag)
+	if err != nil {
+		return f
+	}
+
+	tags.Remove(key)
+	f.tag = tags.String()
+
+	return f
+}
+
 func (ds *dynamicStructImpl) New() interface{} {
 	return reflect.New(ds.definition).Interface()
 }
 
+func (ds *dynamicStructImpl) Acquire() interface{} {
+	if value := ds.pool.Get(); value != nil {
+		return value
+	}
+	return ds.New()
+}
+
+func (ds *dynamicStructImpl) Release(value interface{}) error {
+	valueOf := reflect.ValueOf(value)
+
+	if valueOf.Kind() != reflect.Ptr || valueOf.IsNil() {
+		return errors.New("Release: expected a pointer as an argument")
+	}
+
+	if valueOf.Type().Elem() != ds.definition {
+		return fmt.Errorf("Release: expected a pointer to dynamic struct, but got %T", value)
+	}
+
+	valueOf.Elem().Set(reflect.Zero(ds.definition))
+	ds.pool.Put(value)
+
+	return nil
+}
+
+func (ds *dynamicStructImpl) Type() reflect.Type {
+	return ds.definition
+}
+
+func (ds *dynamicStructImpl) Fields() []reflect.StructField {
+	fields := make([]reflect.StructField, 0, ds.definition.NumField())
+	for i := 0; i < ds.definition.NumField(); i++ {
+		fields = append(fields, ds.definition.Field(i))
+	}
+	return fields
+}
+
+func (ds *dynamicStructImpl) Size() uintptr {
+	return ds.definition.Size()
+}
+
+func (ds *dynamicStructImpl) Align() int {
+	return ds.definition.Align()
+}
+
+func (ds *dynamicStructImpl) ToBuilder() Builder {
+	builder := NewStruct().(*builderImpl)
+	for _, field := range ds.Fields() {
+		builder.addField(field.Name, field.PkgPath, declaredType{typ: field.Type}, string(field.Tag), field.Anonymous)
+	}
+	return builder
+}
+
 func (ds *dynamicStructImpl) NewSliceOfStructs() interface{} {
 	return reflect.New(reflect.SliceOf(ds.definition)).Interface()
 }
@@ -1,7 +1,9 @@
 package dynamicstruct
 
 import (
+	"io"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -74,6 +76,81 @@ func TestExtendStruct(t *testing.T) {
 	}
 }
 
+func TestExtendStructType(t *testing.T) {
+	type A struct {
+		Field    int `key:"value"`
+		Pointer  *A
+		Function func() error
+	}
+
+	for _, typ := range []reflect.Type{reflect.TypeOf(A{}), reflect.TypeOf(&A{})} {
+		builder, err := ExtendStructType(typ)
+		if err != nil {
+			t.Fatalf(`TestExtendStructType - expected not to have error got %#v`, err)
+		}
+
+		expected := &fieldConfigImpl{
+			name: "Field",
+			typ:  declaredType{typ: reflect.TypeOf(0)},
+			tag:  `key:"value"`,
+		}
+		if field := builder.GetField("Field"); !reflect.DeepEqual(field, expected) {
+			t.Errorf(`TestExtendStructType - expected field to be %#v got %#v`, expected, field)
+		}
+
+		definition := reflect.TypeOf(builder.Build().New()).Elem()
+		if definition.NumField() != 3 || definition.Field(1).Type != reflect.TypeOf(&A{}) {
+			t.Errorf(`TestExtendStructType - expected definition with fields of A got %s`, definition)
+		}
+	}
+
+	for _, typ := range []reflect.Type{nil, reflect.TypeOf(0), reflect.TypeOf([]A{})} {
+		if _, err := ExtendStructType(typ); err == nil {
+			t.Errorf(`TestExtendStructType - expected error for %v`, typ)
+		}
+	}
+}
+
+func TestExtendType(t *testing.T) {
+	type A struct {
+		Field int `key:"value"`
+	}
+
+	builder, err := ExtendType[A]()
+	if err != nil || !builder.HasField("Field") {
+		t.Errorf(`TestExtendType - expected to have field "Field" got %#v`, err)
+	}
+
+	builder, err = ExtendType[*A]()
+	if err != nil || !builder.HasField("Field") {
+		t.Errorf(`TestExtendType - expected to have field "Field" got %#v`, err)
+	}
+
+	if _, err := ExtendType[string](); err == nil {
+		t.Error(`TestExtendType - expected error for string`)
+	}
+
+	if _, err := ExtendType[interface{}](); err == nil {
+		t.Error(`TestExtendType - expected error for interface{}`)
+	}
+}
+
+func TestMergeStructs_NilPointer(t *testing.T) {
+	type A struct {
+		FieldOne int
+	}
+	type B struct {
+		FieldTwo string
+	}
+
+	var nilPointer *A
+
+	builder := MergeStructs(nilPointer, reflect.TypeOf(B{}))
+	if !builder.HasField("FieldOne") || !builder.HasField("FieldTwo") {
+		t.Error(`TestMergeStructs_NilPointer - expected to have fields "FieldOne" and "FieldTwo"`)
+	}
+}
+
 func TestMergeStructs(t *testing.T) {
 	value := MergeStructs(
 		struct {
@@ -169,6 +246,116 @@ func TestBuilderImpl_RemoveField(t *testing.T) {
 	}
 }
 
+func TestBuilderImpl_InsertField(t *testing.T) {
+	builder := NewStruct().
+		AddField("First", 0, "").
+		AddField("Last", 0, "").
+		InsertFieldBefore("Last", "Middle", 0, "").
+		InsertFieldAfter("First", "Second", "", `json:"second"`).
+		InsertFieldBefore("First", "Zeroth", 0, "").
+		InsertFieldAfter("Last", "Final", 0, "").
+		InsertFieldBefore("Undefined", "Appended", 0, "").
+		InsertFieldAfter("Undefined", "AppendedAgain", 0, "")
+
+	expected := []string{"Zeroth", "First", "Second", "Middle", "Last", "Final", "Appended", "AppendedAgain"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestBuilderImpl_InsertField - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	field := builder.GetField("Second").(*fieldConfigImpl)
+	if field.typ != "" || field.tag != `json:"second"` {
+		t.Errorf(`TestBuilderImpl_InsertField - expected inserted field to keep type and tag got %#v`, field)
+	}
+}
+
+func TestBuilderImpl_MoveField(t *testing.T) {
+	builder := NewStruct().
+		AddField("A", 0, "").
+		AddField("B", 0, "").
+		AddField("C", 0, "").
+		AddField("D", 0, "")
+
+	tests := []struct {
+		name     string
+		index    int
+		expected []string
+	}{
+		{name: "D", index: 0, expected: []string{"D", "A", "B", "C"}},
+		{name: "D", index: 2, expected: []string{"A", "B", "D", "C"}},
+		{name: "A", index: 100, expected: []string{"B", "D", "C", "A"}},
+		{name: "C", index: -1, expected: []string{"C", "B", "D", "A"}},
+		{name: "B", index: 1, expected: []string{"C", "B", "D", "A"}},
+		{name: "Undefined", index: 0, expected: []string{"C", "B", "D", "A"}},
+	}
+
+	for _, test := range tests {
+		builder.MoveField(test.name, test.index)
+
+		if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, test.expected) {
+			t.Errorf(`TestBuilderImpl_MoveField - expected fields to be %#v got %#v`, test.expected, names)
+		}
+	}
+}
+
+func TestBuilderImpl_RenameField(t *testing.T) {
+	builder := NewStruct().
+		AddField("First", 0, `json:"first"`).
+		AddField("Second", "", "").(*builderImpl)
+	builder.addField("hidden", "example.com/pkg", 0, "", false)
+
+	builder.
+		RenameField("First", "Renamed").
+		RenameField("hidden", "Visible").
+		RenameField("Undefined", "Other")
+
+	expected := []string{"Renamed", "Second", "Visible"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestBuilderImpl_RenameField - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	field := builder.GetField("Renamed").(*fieldConfigImpl)
+	if field.typ != 0 || field.tag != `json:"first"` {
+		t.Errorf(`TestBuilderImpl_RenameField - expected renamed field to keep type and tag got %#v`, field)
+	}
+
+	if field := builder.GetField("Visible").(*fieldConfigImpl); field.pkg != "" {
+		t.Errorf(`TestBuilderImpl_RenameField - expected exported field to have empty package path got %#v`, field.pkg)
+	}
+
+	if _, err := builder.BuildE(); err != nil {
+		t.Errorf(`TestBuilderImpl_RenameField - expected not to have error got %#v`, err)
+	}
+}
+
+func TestBuilderImpl_ReorderFields(t *testing.T) {
+	builder := NewStruct().
+		AddField("A", 0, "").
+		AddField("B", 0, "").
+		AddField("C", 0, "").
+		AddField("D", 0, "").
+		ReorderFields("C", "Undefined", "A", "C")
+
+	expected := []string{"C", "A", "B", "D"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestBuilderImpl_ReorderFields - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	typ := reflect.TypeOf(builder.Build().New()).Elem()
+	for i, name := range expected {
+		if typ.Field(i).Name != name {
+			t.Errorf(`TestBuilderImpl_ReorderFields - expected field #%d to be %#v got %#v`, i, name, typ.Field(i).Name)
+		}
+	}
+}
+
+func testBuilderFieldNames(builder Builder) []string {
+	var names []string
+	for _, field := range builder.(*builderImpl).fields {
+		names = append(names, field.name)
+	}
+	return names
+}
+
 func TestBuilderImpl_HasField(t *testing.T) {
 	builder := &builderImpl{
 		fields: []*fieldConfigImpl{},
@@ -227,6 +414,66 @@ func TestBuilderImpl_GetField(t *testing.T) {
 	}
 }
 
+func TestBuilderImpl_Fields(t *testing.T) {
+	builder := NewStruct().
+		AddField("Integer", 0, `json:"int"`).
+		AddFieldType("Type", reflect.TypeOf(""), "").(*builderImpl)
+	builder.addField("hidden", "example.com/pkg", 0.0, "", false)
+	builder.addField("Embedded", "", struct{ Value int }{}, "", true)
+
+	expected := []struct {
+		name      string
+		typ       reflect.Type
+		tag       string
+		anonymous bool
+		pkg       string
+	}{
+		{name: "Integer", typ: reflect.TypeOf(0), tag: `json:"int"`},
+		{name: "Type", typ: reflect.TypeOf("")},
+		{name: "hidden", typ: reflect.TypeOf(0.0), pkg: "example.com/pkg"},
+		{name: "Embedded", typ: reflect.TypeOf(struct{ Value int }{}), anonymous: true},
+	}
+
+	fields := builder.Fields()
+	if len(fields) != len(expected) {
+		t.Fatalf(`TestBuilderImpl_Fields - expected %d fields got %d`, len(expected), len(fields))
+	}
+
+	for i, field := range fields {
+		if field.Name() != expected[i].name || field.Type() != expected[i].typ || field.Tag() != expected[i].tag ||
+			field.Anonymous() != expected[i].anonymous || field.PkgPath() != expected[i].pkg {
+			t.Errorf(`TestBuilderImpl_Fields - expected field %#v got %#v`, expected[i], field)
+		}
+	}
+
+	fields[0].SetTag(`json:"integer"`)
+	if tag := builder.GetField("Integer").Tag(); tag != `json:"integer"` {
+		t.Errorf(`TestBuilderImpl_Fields - expected field to be editable got %#v`, tag)
+	}
+
+	if fields := NewStruct().Fields(); len(fields) != 0 {
+		t.Errorf(`TestBuilderImpl_Fields - expected no fields got %#v`, fields)
+	}
+}
+
+func TestBuilderImpl_AddField_ReflectType(t *testing.T) {
+	typ := reflect.TypeOf(0)
+
+	definition := NewStruct().
+		AddField("Value", typ, "").
+		Build().
+		Type()
+	if field := definition.Field(0); field.Type != reflect.TypeOf(typ) {
+		t.Errorf(`TestBuilderImpl_AddField_ReflectType - expected field of type %s got %s`, reflect.TypeOf(typ), field.Type)
+	}
+
+	builder := NewStruct().AddField("Value", 0, "")
+	builder.GetField("Value").SetType(typ)
+	if field := builder.Build().Type().Field(0); field.Type != reflect.TypeOf(typ) {
+		t.Errorf(`TestBuilderImpl_AddField_ReflectType - expected field of type %s got %s`, reflect.TypeOf(typ), field.Type)
+	}
+}
+
 func TestFieldConfigImpl_SetTag(t *testing.T) {
 	field := &fieldConfigImpl{}
 
@@ -246,3 +493,92 @@ func TestFieldConfigImpl_SetType(t *testing.T) {
 		t.Errorf(`TestFieldConfigImpl_SetType - expected type to be as for %#v got %#v`, 1000, field.typ)
 	}
 }
+
+func TestDynamicStructImpl_Introspection(t *testing.T) {
+	builder := NewStruct().
+		AddField("Flag", false, `json:"flag"`).
+		AddField("Integer", int64(0), "").(*builderImpl)
+	builder.addField("hidden", "example.com/pkg", "", "", false)
+
+	dynamicStruct := builder.Build()
+
+	typ := dynamicStruct.Type()
+	if typ != reflect.TypeOf(dynamicStruct.New()).Elem() {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected type of instance got %s`, typ)
+	}
+
+	if dynamicStruct.Size() != typ.Size() || dynamicStruct.Size() != 16+reflect.TypeOf("").Size() {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected size %d got %d`, typ.Size(), dynamicStruct.Size())
+	}
+
+	if dynamicStruct.Align() != reflect.TypeOf(int64(0)).Align() {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected align %d got %d`, reflect.TypeOf(int64(0)).Align(), dynamicStruct.Align())
+	}
+
+	fields := dynamicStruct.Fields()
+	if len(fields) != 3 || fields[0].Name != "Flag" || fields[0].Tag != `json:"flag"` || fields[2].PkgPath != "example.com/pkg" {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected fields of definition got %#v`, fields)
+	}
+
+	extended := dynamicStruct.ToBuilder()
+	if definition := extended.Build().Type(); definition != typ {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected same definition got %s`, definition)
+	}
+
+	extended.AddField("Text", "", "").GetField("Flag").SetTag(`json:"other"`)
+
+	definition := extended.Build().Type()
+	if definition.NumField() != 4 || definition.Field(0).Tag != `json:"other"` {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected extended definition got %s`, definition)
+	}
+
+	if field := dynamicStruct.Type().Field(0); field.Tag != `json:"flag"` || dynamicStruct.Type().NumField() != 3 {
+		t.Errorf(`TestDynamicStructImpl_Introspection - expected original definition to be unchanged got %#v`, field)
+	}
+}
+
+func TestBuilderImpl_AddInterfaceField(t *testing.T) {
+	readerType := reflect.TypeOf((*io.Reader)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	builder := NewStruct().
+		AddInterfaceField("Reader", (*io.Reader)(nil), `json:"-"`).
+		AddInterfaceField("Error", errorType, "").
+		AddInterfaceField("Any", (*interface{})(nil), "")
+
+	definition := builder.Build().Type()
+	expected := []reflect.Type{readerType, errorType, reflect.TypeOf((*interface{})(nil)).Elem()}
+	for i, typ := range expected {
+		if definition.Field(i).Type != typ {
+			t.Errorf(`TestBuilderImpl_AddInterfaceField - expected field #%d to be %s got %s`, i, typ, definition.Field(i).Type)
+		}
+	}
+
+	instance := builder.Build().New()
+	writer, _ := NewWriter(instance)
+	if err := writer.SetField("Reader", strings.NewReader("text")); err != nil {
+		t.Errorf(`TestBuilderImpl_AddInterfaceField - expected not to have error got %#v`, err)
+	}
+	if err := writer.SetField("Reader", 5); err == nil {
+		t.Error(`TestBuilderImpl_AddInterfaceField - expected error for value which is not io.Reader`)
+	}
+
+	if _, ok := NewReader(instance).GetField("Reader").Interface().(*strings.Reader); !ok {
+		t.Errorf(`TestBuilderImpl_AddInterfaceField - expected to hold *strings.Reader got %#v`, instance)
+	}
+
+	invalid := []interface{}{nil, strings.NewReader(""), (*strings.Reader)(nil), reflect.TypeOf(0), reflect.Type(nil)}
+	for _, typ := range invalid {
+		_, err := NewStruct().AddInterfaceField("Invalid", typ, "").BuildE()
+		if buildError, ok := err.(*BuildError); !ok || len(buildError.Fields) != 1 || !strings.HasPrefix(buildError.Fields[0].Reason, "type is not") {
+			t.Errorf(`TestBuilderImpl_AddInterfaceField - expected error for %#v got %#v`, typ, err)
+		}
+	}
+
+	for _, builder := range []Builder{NewStruct().Immutable(), Synchronized(NewStruct())} {
+		builder = builder.AddInterfaceField("Reader", (*io.Reader)(nil), "")
+		if definition := builder.Build().Type(); definition.Field(0).Type != readerType {
+			t.Errorf(`TestBuilderImpl_AddInterfaceField - expected interface field got %s`, definition)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package dynamicstruct
+
+import (
+	"container/list"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type (
+	// TypeCache keeps already built dynamic structs, so Builders
+	// with identical fields' definitions get the same DynamicStruct,
+	// without calling reflect.StructOf again. Definitions are identical
+	// when all fields have same names, package paths, types, tags and
+	// anonymity, in the same order. Least recently used definitions
+	// are evicted once cache reaches its maximal size.
+	// It is safe for concurrent use.
+	TypeCache struct {
+		mutex      sync.Mutex
+		maxEntries int
+		entries    map[string][]*list.Element
+		recent     *list.List
+		hits       uint64
+		misses     uint64
+		evictions  uint64
+	}
+
+	// TypeCacheStats holds TypeCache's usage statistics.
+	TypeCacheStats struct {
+		// Hits counts builds which reused cached definition.
+		Hits uint64
+		// Misses counts builds which created new definition.
+		Misses uint64
+		// Evictions counts definitions removed because of maximal size.
+		Evictions uint64
+		// Size is current number of cached definitions.
+		Size int
+		// MaxSize is maximal number of cached definitions.
+		MaxSize int
+	}
+
+	typeCacheEntry struct {
+		fingerprint   string
+		types         []reflect.Type
+		dynamicStruct *dynamicStructImpl
+	}
+)
+
+// DefaultTypeCache is process-wide TypeCache, which can be shared
+// between all Builders.
+//
+// builder.UseCache(dynamicstruct.DefaultTypeCache)
+//
+var DefaultTypeCache = NewTypeCache(1024)
+
+// NewTypeCache returns new empty TypeCache which holds
+// at most maxEntries definitions. Non-positive maxEntries
+// means that cache is not limited.
+//
+// cache := dynamicstruct.NewTypeCache(100)
+//
+func NewTypeCache(maxEntries int) *TypeCache {
+	return &TypeCache{
+		maxEntries: maxEntries,
+		entries:    map[string][]*list.Element{},
+		recent:     list.New(),
+	}
+}
+
+// Stats returns current usage statistics of TypeCache.
+//
+// stats := cache.Stats()
+//
+func (c *TypeCache) Stats() TypeCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return TypeCacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.recent.Len(),
+		MaxSize:   c.maxEntries,
+	}
+}
+
+// Purge removes all cached definitions and resets statistics.
+//
+// cache.Purge()
+//
+func (c *TypeCache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = map[string][]*list.Element{}
+	c.recent.Init()
+	c.hits, c.misses, c.evictions = 0, 0, 0
+}
+
+func (c *TypeCache) build(structFields []reflect.StructField) *dynamicStructImpl {
+	fingerprint, types := typeCacheFingerprint(structFields)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, element := range c.entries[fingerprint] {
+		entry := element.Value.(*typeCacheEntry)
+		if typeCacheSameTypes(entry.types, types) {
+			c.hits++
+			c.recent.MoveToFront(element)
+			return entry.dynamicStruct
+		}
+	}
+
+	c.misses++
+
+	entry := &typeCacheEntry{
+		fingerprint: fingerprint,
+		types:       types,
+		dynamicStruct: &dynamicStructImpl{
+			definition: reflect.StructOf(structFields),
+		},
+	}
+	c.entries[fingerprint] = append(c.entries[fingerprint], c.recent.PushFront(entry))
+
+	if c.maxEntries > 0 && c.recent.Len() > c.maxEntries {
+		c.evict(c.recent.Back())
+	}
+
+	return entry.dynamicStruct
+}
+
+func (c *TypeCache) evict(element *list.Element) {
+	entry := c.recent.Remove(element).(*typeCacheEntry)
+
+	bucket := c.entries[entry.fingerprint]
+	for i := range bucket {
+		if bucket[i] == element {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+
+	if len(bucket) == 0 {
+		delete(c.entries, entry.fingerprint)
+	} else {
+		c.entries[entry.fingerprint] = bucket
+	}
+
+	c.evictions++
+}
+
+func typeCacheFingerprint(structFields []reflect.StructField) (string, []reflect.Type) {
+	var fingerprint strings.Builder
+	types := make([]reflect.Type, 0, len(structFields))
+
+	for _, field := range structFields {
+		fingerprint.WriteString(field.Name)
+		fingerprint.WriteByte(' ')
+		fingerprint.WriteString(field.PkgPath)
+		fingerprint.WriteByte(' ')
+		fingerprint.WriteString(strconv.Itoa(len(field.Tag)))
+		fingerprint.WriteByte(' ')
+		fingerprint.WriteString(string(field.Tag))
+		if field.Anonymous {
+			fingerprint.WriteString(" anonymous")
+		}
+		fingerprint.WriteByte(';')
+
+		types = append(types, field.Type)
+	}
+
+	return fingerprint.String(), types
+}
+
+func typeCacheSameTypes(first []reflect.Type, second []reflect.Type) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypeCache(t *testing.T) {
+	cache := NewTypeCache(2)
+
+	first := NewStruct().UseCache(cache).AddField("Field", 0, `json:"field"`).Build()
+	second := NewStruct().UseCache(cache).AddField("Field", 0, `json:"field"`).Build()
+
+	if first != second {
+		t.Errorf(`TestTypeCache - expected identical definitions to share DynamicStruct got %#v and %#v`, first, second)
+	}
+
+	differentTag := NewStruct().UseCache(cache).AddField("Field", 0, `json:"other"`).Build()
+	differentType := NewStruct().UseCache(cache).AddField("Field", int64(0), `json:"field"`).Build()
+
+	if differentTag == first || differentType == first || differentTag == differentType {
+		t.Error(`TestTypeCache - expected different definitions not to share DynamicStruct`)
+	}
+
+	expected := TypeCacheStats{
+		Hits:      1,
+		Misses:    3,
+		Evictions: 1,
+		Size:      2,
+		MaxSize:   2,
+	}
+	if stats := cache.Stats(); !reflect.DeepEqual(stats, expected) {
+		t.Errorf(`TestTypeCache - expected stats to be %#v got %#v`, expected, stats)
+	}
+
+	evicted := NewStruct().UseCache(cache).AddField("Field", 0, `json:"field"`).Build()
+	if evicted == first {
+		t.Error(`TestTypeCache - expected least recently used definition to be evicted`)
+	}
+	if reflect.TypeOf(evicted.New()) != reflect.TypeOf(first.New()) {
+		t.Error(`TestTypeCache - expected rebuilt definition to have the same type`)
+	}
+
+	cache.Purge()
+	if stats := cache.Stats(); !reflect.DeepEqual(stats, TypeCacheStats{MaxSize: 2}) {
+		t.Errorf(`TestTypeCache - expected empty stats got %#v`, stats)
+	}
+
+	uncached := NewStruct().UseCache(cache).UseCache(nil).AddField("Field", 0, `json:"field"`)
+	if uncached.Build() == uncached.Build() {
+		t.Error(`TestTypeCache - expected not to share DynamicStruct without cache`)
+	}
+}
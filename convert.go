@@ -0,0 +1,165 @@
+package dynamicstruct
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func convertToSigned(value reflect.Value, bits int) (int64, error) {
+	number, err := convertToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	result, accuracy := number.Int64()
+	if accuracy != big.Exact || (bits < 64 && (result < -1<<(bits-1) || result > 1<<(bits-1)-1)) {
+		return 0, fmt.Errorf("value %v can't be converted to int%d without loss", number, bits)
+	}
+
+	return result, nil
+}
+
+func convertToUnsigned(value reflect.Value, bits int) (uint64, error) {
+	number, err := convertToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	result, accuracy := number.Uint64()
+	if accuracy != big.Exact || number.Sign() < 0 || (bits < 64 && result > 1<<bits-1) {
+		return 0, fmt.Errorf("value %v can't be converted to uint%d without loss", number, bits)
+	}
+
+	return result, nil
+}
+
+func convertToFloat(value reflect.Value, bits int) (float64, error) {
+	if isFloatKind(value.Kind()) && math.IsNaN(value.Float()) {
+		return value.Float(), nil
+	}
+
+	number, err := convertToBigFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64
+	var accuracy big.Accuracy
+
+	if bits == 32 {
+		var small float32
+		small, accuracy = number.Float32()
+		result = float64(small)
+	} else {
+		result, accuracy = number.Float64()
+	}
+
+	if accuracy != big.Exact {
+		return 0, fmt.Errorf("value %v can't be converted to float%d without loss", number, bits)
+	}
+
+	return result, nil
+}
+
+func convertToString(value reflect.Value) (string, error) {
+	switch {
+	case value.Kind() == reflect.String:
+		return value.String(), nil
+	case isSignedKind(value.Kind()):
+		return strconv.FormatInt(value.Int(), 10), nil
+	case isUnsignedKind(value.Kind()):
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case isFloatKind(value.Kind()):
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), nil
+	case value.Kind() == reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	default:
+		return "", fmt.Errorf("value of type %s can't be converted to string", value.Type())
+	}
+}
+
+func convertToBool(value reflect.Value) (bool, error) {
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), nil
+	case reflect.String:
+		result, err := strconv.ParseBool(strings.TrimSpace(value.String()))
+		if err != nil {
+			return false, fmt.Errorf("value %q can't be converted to bool", value.String())
+		}
+		return result, nil
+	default:
+		return false, fmt.Errorf("value of type %s can't be converted to bool", value.Type())
+	}
+}
+
+func convertToBigFloat(value reflect.Value) (*big.Float, error) {
+	switch {
+	case isSignedKind(value.Kind()):
+		return new(big.Float).SetInt64(value.Int()), nil
+	case isUnsignedKind(value.Kind()):
+		return new(big.Float).SetUint64(value.Uint()), nil
+	case isFloatKind(value.Kind()):
+		if math.IsNaN(value.Float()) {
+			return nil, errors.New("NaN can't be converted to a number")
+		}
+		return new(big.Float).SetFloat64(value.Float()), nil
+	case value.Kind() == reflect.String:
+		text := strings.TrimSpace(value.String())
+
+		if integer, ok := new(big.Int).SetString(text, 10); ok {
+			return new(big.Float).SetInt(integer), nil
+		}
+
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q can't be converted to a number", value.String())
+		}
+
+		return convertToBigFloat(reflect.ValueOf(number))
+	default:
+		return nil, fmt.Errorf("value of type %s can't be converted to a number", value.Type())
+	}
+}
+
+func isNumberKind(kind reflect.Kind) bool {
+	return isSignedKind(kind) || isUnsignedKind(kind) || isFloatKind(kind)
+}
+
+func isNegative(value reflect.Value) bool {
+	switch {
+	case isSignedKind(value.Kind()):
+		return value.Int() < 0
+	case isFloatKind(value.Kind()):
+		return value.Float() < 0
+	default:
+		return false
+	}
+}
+
+func isSignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUnsignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
@@ -1 +1,3 @@
 module github.com/ompluscator/dynamic-struct
+
+go 1.18
@@ -0,0 +1,101 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func (ds *dynamicStructImpl) GoSource(name string) (string, error) {
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf(`GoSource: "%s" is not a valid type name`, name)
+	}
+
+	var source strings.Builder
+
+	source.WriteString("type " + name + " ")
+	if err := writeGoType(&source, ds.definition); err != nil {
+		return "", fmt.Errorf("GoSource: %w", err)
+	}
+
+	formatted, err := format.Source([]byte(source.String()))
+	if err != nil {
+		return "", fmt.Errorf("GoSource: %w", err)
+	}
+
+	return string(formatted) + "\n", nil
+}
+
+func writeGoType(source *strings.Builder, typ reflect.Type) error {
+	if typ.Name() != "" {
+		source.WriteString(typ.String())
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		source.WriteString("*")
+		return writeGoType(source, typ.Elem())
+	case reflect.Slice:
+		source.WriteString("[]")
+		return writeGoType(source, typ.Elem())
+	case reflect.Array:
+		source.WriteString("[" + strconv.Itoa(typ.Len()) + "]")
+		return writeGoType(source, typ.Elem())
+	case reflect.Map:
+		source.WriteString("map[")
+		if err := writeGoType(source, typ.Key()); err != nil {
+			return err
+		}
+		source.WriteString("]")
+		return writeGoType(source, typ.Elem())
+	case reflect.Struct:
+		return writeGoStruct(source, typ)
+	default:
+		source.WriteString(typ.String())
+		return nil
+	}
+}
+
+func writeGoStruct(source *strings.Builder, typ reflect.Type) error {
+	source.WriteString("struct {\n")
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Name() == "" {
+				return fmt.Errorf(`embedded field "%s" has unnamed type %s`, field.Name, field.Type)
+			}
+		} else {
+			source.WriteString(field.Name + " ")
+		}
+
+		if err := writeGoType(source, field.Type); err != nil {
+			return err
+		}
+
+		if field.Tag != "" {
+			source.WriteString(" " + goTagLiteral(string(field.Tag)))
+		}
+
+		source.WriteString("\n")
+	}
+
+	source.WriteString("}")
+	return nil
+}
+
+func goTagLiteral(tag string) string {
+	if strings.Contains(tag, "`") {
+		return strconv.Quote(tag)
+	}
+	return "`" + tag + "`"
+}
@@ -0,0 +1,71 @@
+package dynamicstruct
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDynamicStructImpl_GoSource(t *testing.T) {
+	type Embedded struct {
+		Value string
+	}
+
+	integer := 0
+
+	dynamicStruct := NewStruct().
+		AddField("Integer", 0, `json:"int"`).
+		AddField("Pointer", &integer, "").
+		AddField("Time", time.Time{}, `json:"time" note:"has a `+"`"+`"`).
+		AddField("Matrix", [2][]float64{}, "").
+		AddField("Lookup", map[string]*Embedded{}, "").
+		AddField("Nested", struct {
+			Text  string `json:"text"`
+			Inner struct {
+				Flag bool
+			}
+		}{}, `json:"nested"`).
+		Build()
+
+	source, err := dynamicStruct.GoSource("Generated")
+	if err != nil {
+		t.Fatalf(`TestDynamicStructImpl_GoSource - expected not to have error got %#v`, err)
+	}
+
+	expected := strings.Join([]string{
+		"type Generated struct {",
+		"\tInteger int `json:\"int\"`",
+		"\tPointer *int",
+		"\tTime    time.Time \"json:\\\"time\\\" note:\\\"has a `\\\"\"",
+		"\tMatrix  [2][]float64",
+		"\tLookup  map[string]*dynamicstruct.Embedded",
+		"\tNested  struct {",
+		"\t\tText  string `json:\"text\"`",
+		"\t\tInner struct {",
+		"\t\t\tFlag bool",
+		"\t\t}",
+		"\t} `json:\"nested\"`",
+		"}",
+		"",
+	}, "\n")
+
+	if source != expected {
+		t.Errorf(`TestDynamicStructImpl_GoSource - expected source to be %s got %s`, expected, source)
+	}
+
+	if _, err := dynamicStruct.GoSource("not valid"); err == nil {
+		t.Error(`TestDynamicStructImpl_GoSource - expected error for invalid type name`)
+	}
+
+	dynamicStruct = MergeStructs(struct{ Embedded }{}).Build()
+	if source, err := dynamicStruct.GoSource("Valid"); err != nil || !strings.Contains(source, "\tdynamicstruct.Embedded\n") {
+		t.Errorf(`TestDynamicStructImpl_GoSource - expected embedded field got %s, %#v`, source, err)
+	}
+
+	unnamed := NewStruct().AddField("Value", 0, "").Build().New()
+	builder := NewStruct().(*builderImpl)
+	builder.addField("Unnamed", "", unnamed, "", true)
+	if _, err := builder.Build().GoSource("Invalid"); err == nil {
+		t.Error(`TestDynamicStructImpl_GoSource - expected error for embedded field with unnamed type`)
+	}
+}
@@ -0,0 +1,152 @@
+package dynamicstruct
+
+import (
+	"reflect"
+)
+
+// immutableBuilder is copy-on-write Builder. It never changes
+// its own fields' definitions, but applies every change
+// to a new copy of them.
+type immutableBuilder struct {
+	builder *builderImpl
+}
+
+func (b *immutableBuilder) derive(change func(builder *builderImpl)) Builder {
+	builder := b.builder.clone()
+	change(builder)
+
+	return &immutableBuilder{
+		builder: builder,
+	}
+}
+
+func (b *immutableBuilder) AddField(name string, typ interface{}, tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddField(name, typ, tag)
+	})
+}
+
+func (b *immutableBuilder) AddInterfaceField(name string, typ interface{}, tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddInterfaceField(name, typ, tag)
+	})
+}
+
+func (b *immutableBuilder) AddStructField(name string, define func(builder Builder), tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddStructField(name, define, tag)
+	})
+}
+
+func (b *immutableBuilder) AddSliceOfStructsField(name string, define func(builder Builder), tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddSliceOfStructsField(name, define, tag)
+	})
+}
+
+func (b *immutableBuilder) AddMapOfStructsField(name string, key interface{}, define func(builder Builder), tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddMapOfStructsField(name, key, define, tag)
+	})
+}
+
+func (b *immutableBuilder) AddFieldType(name string, typ reflect.Type, tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddFieldType(name, typ, tag)
+	})
+}
+
+func (b *immutableBuilder) AddFields(fields ...FieldDefinition) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.AddFields(fields...)
+	})
+}
+
+func (b *immutableBuilder) RemoveField(name string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.RemoveField(name)
+	})
+}
+
+func (b *immutableBuilder) InsertFieldBefore(mark string, name string, typ interface{}, tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.InsertFieldBefore(mark, name, typ, tag)
+	})
+}
+
+func (b *immutableBuilder) InsertFieldAfter(mark string, name string, typ interface{}, tag string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.InsertFieldAfter(mark, name, typ, tag)
+	})
+}
+
+func (b *immutableBuilder) MoveField(name string, index int) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.MoveField(name, index)
+	})
+}
+
+func (b *immutableBuilder) RenameField(name string, newName string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.RenameField(name, newName)
+	})
+}
+
+func (b *immutableBuilder) ReorderFields(names ...string) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.ReorderFields(names...)
+	})
+}
+
+func (b *immutableBuilder) HasField(name string) bool {
+	return b.builder.HasField(name)
+}
+
+func (b *immutableBuilder) GetField(name string) FieldConfig {
+	if field := b.builder.getFieldByPath(name); field != nil {
+		return field.clone()
+	}
+	return nil
+}
+
+func (b *immutableBuilder) Fields() []FieldConfig {
+	fields := make([]FieldConfig, 0, len(b.builder.fields))
+	for _, field := range b.builder.fields {
+		fields = append(fields, field.clone())
+	}
+	return fields
+}
+
+func (b *immutableBuilder) MapTags(mapper func(name string, tags Tags) Tags) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.MapTags(mapper)
+	})
+}
+
+func (b *immutableBuilder) GenerateTags(rules ...TagRule) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.GenerateTags(rules...)
+	})
+}
+
+func (b *immutableBuilder) Build() DynamicStruct {
+	return b.builder.Build()
+}
+
+func (b *immutableBuilder) UseCache(cache *TypeCache) Builder {
+	return b.derive(func(builder *builderImpl) {
+		builder.UseCache(cache)
+	})
+}
+
+func (b *immutableBuilder) BuildE() (DynamicStruct, error) {
+	return b.builder.BuildE()
+}
+
+func (b *immutableBuilder) Clone() Builder {
+	return b.builder.clone()
+}
+
+func (b *immutableBuilder) Immutable() Builder {
+	return b
+}
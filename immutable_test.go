@@ -0,0 +1,130 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestBuilderImpl_Clone(t *testing.T) {
+	base, err := MergeStructsWithOptions(MergeOptions{Embedded: EmbeddedExpand}, MergeBase{})
+	if err != nil {
+		t.Fatalf(`TestBuilderImpl_Clone - expected not to have error got %#v`, err)
+	}
+	base.GenerateTags(TagRule{Key: "db", Strategy: SnakeCase})
+
+	clone := base.Clone()
+	if clone == base || clone.Build().Type() != base.Build().Type() {
+		t.Errorf(`TestBuilderImpl_Clone - expected clone with same definition got %#v`, clone)
+	}
+
+	clone.AddField("Tenant", "", "").
+		GenerateTags(TagRule{Key: "json"}).
+		GetField("ID").SetTag(`json:"tenant_id"`)
+	clone.GetField("MergeAudit.CreatedAt").SetTag(`json:"created"`)
+
+	if base.HasField("Tenant") || base.GetField("ID").Tag() != `json:"id"` || base.GetField("MergeAudit.CreatedAt").Tag() != `json:"created_at"` {
+		t.Error(`TestBuilderImpl_Clone - expected base to be unchanged`)
+	}
+
+	if rules := base.(*builderImpl).tagRules; len(rules) != 1 {
+		t.Errorf(`TestBuilderImpl_Clone - expected base to have 1 tag rule got %#v`, rules)
+	}
+
+	definition := clone.Build().Type()
+	if field, _ := definition.FieldByName("CreatedAt"); field.Tag != `json:"created"` {
+		t.Errorf(`TestBuilderImpl_Clone - expected changed nested tag got %#v`, field.Tag)
+	}
+}
+
+func TestImmutableBuilder(t *testing.T) {
+	base := NewStruct().
+		AddField("ID", 0, `json:"id"`).
+		AddField("Name", "", `json:"name"`).
+		Immutable()
+
+	variants := []Builder{
+		base.AddField("Tenant", "", ""),
+		base.RemoveField("Name"),
+		base.InsertFieldBefore("ID", "Tenant", "", ""),
+		base.InsertFieldAfter("ID", "Tenant", "", ""),
+		base.MoveField("Name", 0),
+		base.RenameField("Name", "Title"),
+		base.ReorderFields("Name"),
+		base.MapTags(func(name string, tags Tags) Tags { return Tags{} }),
+		base.GenerateTags(TagRule{Key: "db"}),
+		base.UseCache(NewTypeCache(0)),
+	}
+
+	for _, variant := range variants {
+		if variant == base {
+			t.Errorf(`TestImmutableBuilder - expected new builder got %#v`, variant)
+		}
+		if _, ok := variant.(*immutableBuilder); !ok {
+			t.Errorf(`TestImmutableBuilder - expected immutable builder got %#v`, variant)
+		}
+	}
+
+	base.GetField("ID").SetTag(`json:"changed"`)
+	for _, field := range base.Fields() {
+		field.SetType(nil)
+	}
+
+	expected := []string{"ID", "Name"}
+	if names := testBuilderFieldNames(base.Clone()); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestImmutableBuilder - expected base fields to be %#v got %#v`, expected, names)
+	}
+
+	definition := base.Build().Type()
+	if definition.NumField() != 2 || definition.Field(0).Tag != `json:"id"` || definition.Field(1).Type != reflect.TypeOf("") {
+		t.Errorf(`TestImmutableBuilder - expected base definition to be unchanged got %s`, definition)
+	}
+
+	if variant := variants[5].Build().Type(); variant.Field(1).Name != "Title" {
+		t.Errorf(`TestImmutableBuilder - expected renamed field got %s`, variant)
+	}
+
+	if base.Immutable() != base {
+		t.Error(`TestImmutableBuilder - expected immutable builder to return itself`)
+	}
+
+	clone := base.Clone()
+	if _, ok := clone.(*builderImpl); !ok {
+		t.Errorf(`TestImmutableBuilder - expected mutable clone got %#v`, clone)
+	}
+	clone.AddField("Tenant", "", "")
+	if base.HasField("Tenant") {
+		t.Error(`TestImmutableBuilder - expected base to be unchanged by clone`)
+	}
+
+	if _, err := NewSchema(base); err != nil {
+		t.Errorf(`TestImmutableBuilder - expected not to have error got %#v`, err)
+	}
+}
+
+func TestImmutableBuilder_Concurrent(t *testing.T) {
+	base := NewStruct().
+		AddField("ID", 0, `json:"id"`).
+		Immutable()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			variant := base.
+				AddField("Tenant", "", "").
+				RenameField("ID", "TenantID")
+			if _, err := variant.BuildE(); err != nil {
+				t.Errorf(`TestImmutableBuilder_Concurrent - expected not to have error got %#v`, err)
+			}
+			base.Build()
+		}(i)
+	}
+	wg.Wait()
+
+	if base.HasField("Tenant") || !base.HasField("ID") {
+		t.Error(`TestImmutableBuilder_Concurrent - expected base to be unchanged`)
+	}
+}
@@ -0,0 +1,468 @@
+package dynamicstruct
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type (
+	jsonSchema struct {
+		Ref                  string                 `json:"$ref"`
+		Type                 jsonSchemaTypes        `json:"type"`
+		Format               string                 `json:"format"`
+		Properties           jsonSchemaProperties   `json:"properties"`
+		Required             []string               `json:"required"`
+		Items                *jsonSchema            `json:"items"`
+		AdditionalProperties *jsonSchema            `json:"additionalProperties"`
+		Defs                 map[string]*jsonSchema `json:"$defs"`
+		Definitions          map[string]*jsonSchema `json:"definitions"`
+	}
+
+	jsonSchemaTypes []string
+
+	jsonSchemaProperties []jsonSchemaProperty
+
+	jsonSchemaProperty struct {
+		name   string
+		schema *jsonSchema
+	}
+
+	jsonSchemaResolver struct {
+		root      *jsonSchema
+		resolving map[string]bool
+	}
+)
+
+// NewStructFromJSONSchema returns new instance of Builder interface
+// with fields defined by JSON Schema document.
+// It supports subset of draft 2020-12: object, properties, required,
+// type, format, items, additionalProperties and $ref with $defs.
+// Every property becomes exported field with json tag. Nested objects
+// become nested dynamic structs, arrays become slices and objects
+// without properties become maps. Nested objects, and arrays and maps
+// of them, keep theirs own Builders, available through GetField and
+// FieldConfig.Builder, while objects nested deeper, like in arrays of
+// arrays, get fixed types. Properties which are not required
+// become pointers, unless their type is already nillable, and their
+// tags get omitempty option. Properties without type keep raw JSON.
+//
+// builder, err := dynamicstruct.NewStructFromJSONSchema(schema)
+//
+func NewStructFromJSONSchema(schema []byte) (Builder, error) {
+	var root jsonSchema
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("NewStructFromJSONSchema: %w", err)
+	}
+
+	resolver := &jsonSchemaResolver{
+		root:      &root,
+		resolving: map[string]bool{},
+	}
+
+	object, err := resolver.resolve(&root)
+	if err != nil {
+		return nil, fmt.Errorf("NewStructFromJSONSchema: %w", err)
+	}
+
+	if object.primaryType() != "object" || object.Properties == nil {
+		return nil, errors.New("NewStructFromJSONSchema: expected object with properties as root schema")
+	}
+
+	builder, err := resolver.builder(object)
+	if err != nil {
+		return nil, fmt.Errorf("NewStructFromJSONSchema: %w", err)
+	}
+
+	return builder, nil
+}
+
+func (r *jsonSchemaResolver) builder(schema *jsonSchema) (*builderImpl, error) {
+	builder := NewStruct().(*builderImpl)
+
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	properties := make(map[string]string, len(schema.Properties))
+
+	for _, property := range schema.Properties {
+		name := jsonSchemaFieldName(property.name)
+		if name == "" {
+			return nil, fmt.Errorf(`property "%s" can't be used as field's name`, property.name)
+		}
+
+		if other, ok := properties[name]; ok {
+			return nil, fmt.Errorf(`properties "%s" and "%s" are both mapped to field "%s"`, other, property.name, name)
+		}
+		properties[name] = property.name
+
+		if !jsonSchemaTagName(property.name) {
+			return nil, fmt.Errorf(`property "%s" can't be used as json tag's name`, property.name)
+		}
+
+		typ, err := r.fieldType(property.schema, !required[property.name])
+		if err != nil {
+			return nil, fmt.Errorf(`property "%s": %w`, property.name, err)
+		}
+
+		tag := property.name
+		if !required[property.name] {
+			tag += ",omitempty"
+		}
+
+		builder.AddField(name, typ, "json:"+strconv.Quote(tag))
+	}
+
+	return builder, nil
+}
+
+func (r *jsonSchemaResolver) fieldType(schema *jsonSchema, optional bool) (interface{}, error) {
+	nested, err := r.nested(schema, optional)
+	if err != nil {
+		return nil, err
+	}
+	if nested != nil {
+		return nested, nil
+	}
+
+	typ, err := r.reflectType(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if optional {
+		typ = jsonSchemaOptional(typ)
+	}
+
+	return declaredType{typ: typ}, nil
+}
+
+// nested returns nested struct for object with properties, or for
+// array or map of such objects, and nil for any other schema.
+func (r *jsonSchemaResolver) nested(schema *jsonSchema, optional bool) (*nestedStruct, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	resolved, leave, err := r.enter(schema)
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	if resolved.isObject() {
+		nested := &nestedStruct{
+			kind: reflect.Struct,
+		}
+		if optional || resolved.isNullable() {
+			nested.kind = reflect.Ptr
+		}
+
+		nested.builder, err = r.builder(resolved)
+		if err != nil {
+			return nil, err
+		}
+		return nested, nil
+	}
+
+	var elem *jsonSchema
+	var prefix string
+	nested := &nestedStruct{}
+
+	switch resolved.primaryType() {
+	case "array":
+		elem, prefix = resolved.Items, "items"
+		nested.kind = reflect.Slice
+	case "object":
+		elem, prefix = resolved.AdditionalProperties, "additionalProperties"
+		nested.kind, nested.key = reflect.Map, reflect.TypeOf("")
+	}
+
+	if elem == nil {
+		return nil, nil
+	}
+
+	object, leaveElem, err := r.enter(elem)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", prefix, err)
+	}
+	defer leaveElem()
+
+	if !object.isObject() || object.isNullable() {
+		return nil, nil
+	}
+
+	nested.builder, err = r.builder(object)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", prefix, err)
+	}
+	return nested, nil
+}
+
+func (r *jsonSchemaResolver) reflectType(schema *jsonSchema) (reflect.Type, error) {
+	if schema == nil {
+		return reflect.TypeOf(json.RawMessage{}), nil
+	}
+
+	resolved, leave, err := r.enter(schema)
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	typ, err := r.primaryType(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolved.isNullable() {
+		typ = jsonSchemaOptional(typ)
+	}
+
+	return typ, nil
+}
+
+func (r *jsonSchemaResolver) primaryType(schema *jsonSchema) (reflect.Type, error) {
+	switch schema.primaryType() {
+	case "string":
+		if schema.Format == "date-time" {
+			return reflect.TypeOf(time.Time{}), nil
+		}
+		return reflect.TypeOf(""), nil
+	case "integer":
+		if schema.Format == "int32" {
+			return reflect.TypeOf(int32(0)), nil
+		}
+		return reflect.TypeOf(int64(0)), nil
+	case "number":
+		if schema.Format == "float" {
+			return reflect.TypeOf(float32(0)), nil
+		}
+		return reflect.TypeOf(float64(0)), nil
+	case "boolean":
+		return reflect.TypeOf(false), nil
+	case "array":
+		elem, err := r.reflectType(schema.Items)
+		if err != nil {
+			return nil, fmt.Errorf("items: %w", err)
+		}
+		return reflect.SliceOf(elem), nil
+	case "object":
+		if schema.Properties == nil {
+			elem, err := r.reflectType(schema.AdditionalProperties)
+			if err != nil {
+				return nil, fmt.Errorf("additionalProperties: %w", err)
+			}
+			return reflect.MapOf(reflect.TypeOf(""), elem), nil
+		}
+
+		builder, err := r.builder(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		dynamicStruct, err := builder.BuildE()
+		if err != nil {
+			return nil, err
+		}
+
+		return dynamicStruct.(*dynamicStructImpl).definition, nil
+	default:
+		return reflect.TypeOf(json.RawMessage{}), nil
+	}
+}
+
+// enter resolves schema's reference and marks it as being resolved,
+// until returned leave is called, so recursive references are detected.
+func (r *jsonSchemaResolver) enter(schema *jsonSchema) (*jsonSchema, func(), error) {
+	if schema.Ref == "" {
+		return schema, func() {}, nil
+	}
+
+	if r.resolving[schema.Ref] {
+		return nil, nil, fmt.Errorf(`recursive reference "%s" is not supported`, schema.Ref)
+	}
+
+	resolved, err := r.resolve(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.resolving[schema.Ref] = true
+	return resolved, func() { delete(r.resolving, schema.Ref) }, nil
+}
+
+func (r *jsonSchemaResolver) resolve(schema *jsonSchema) (*jsonSchema, error) {
+	if schema.Ref == "" {
+		return schema, nil
+	}
+
+	if schema.Ref == "#" {
+		return r.root, nil
+	}
+
+	var definitions map[string]*jsonSchema
+	var name string
+
+	switch {
+	case strings.HasPrefix(schema.Ref, "#/$defs/"):
+		definitions, name = r.root.Defs, strings.TrimPrefix(schema.Ref, "#/$defs/")
+	case strings.HasPrefix(schema.Ref, "#/definitions/"):
+		definitions, name = r.root.Definitions, strings.TrimPrefix(schema.Ref, "#/definitions/")
+	default:
+		return nil, fmt.Errorf(`reference "%s" is not supported`, schema.Ref)
+	}
+
+	definition, ok := definitions[name]
+	if !ok || definition == nil {
+		return nil, fmt.Errorf(`reference "%s" is not defined`, schema.Ref)
+	}
+
+	return r.resolve(definition)
+}
+
+func (s *jsonSchema) primaryType() string {
+	var types []string
+
+	for _, typ := range s.Type {
+		if typ != "null" {
+			types = append(types, typ)
+		}
+	}
+
+	if len(types) == 0 && s.Properties != nil {
+		return "object"
+	}
+	if len(types) != 1 {
+		return ""
+	}
+
+	return types[0]
+}
+
+func (s *jsonSchema) isObject() bool {
+	return s.primaryType() == "object" && s.Properties != nil
+}
+
+func (s *jsonSchema) isNullable() bool {
+	for _, typ := range s.Type {
+		if typ == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return errors.New(`"type" must be a string or an array of strings`)
+	}
+
+	*t = multiple
+	return nil
+}
+
+func (p *jsonSchemaProperties) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return errors.New(`"properties" must be an object`)
+	}
+
+	properties := jsonSchemaProperties{}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		var schema jsonSchema
+		if err := decoder.Decode(&schema); err != nil {
+			return err
+		}
+
+		properties = append(properties, jsonSchemaProperty{
+			name:   token.(string),
+			schema: &schema,
+		})
+	}
+
+	*p = properties
+	return nil
+}
+
+func (s *jsonSchema) UnmarshalJSON(data []byte) error {
+	type plain jsonSchema
+
+	var boolean bool
+	if err := json.Unmarshal(data, &boolean); err == nil {
+		*s = jsonSchema{}
+		return nil
+	}
+
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+func jsonSchemaOptional(typ reflect.Type) reflect.Type {
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		return typ
+	default:
+		return reflect.PtrTo(typ)
+	}
+}
+
+func jsonSchemaFieldName(property string) string {
+	var name strings.Builder
+
+	upper := true
+	for _, r := range property {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if name.Len() == 0 && !unicode.IsUpper(unicode.ToUpper(r)) {
+			name.WriteRune('X')
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		name.WriteRune(r)
+	}
+
+	return name.String()
+}
+
+// jsonSchemaTagName reports whether property's name can be used
+// as name in json tag, following the same rules as encoding/json.
+func jsonSchemaTagName(property string) bool {
+	for _, r := range property {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,218 @@
+package dynamicstruct
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewStructFromJSONSchema(t *testing.T) {
+	schema := []byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"display_name": {"type": "string"},
+			"created_at": {"type": "string", "format": "date-time"},
+			"score": {"type": ["number", "null"]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"address": {"$ref": "#/$defs/address"},
+			"labels": {"type": "object", "additionalProperties": {"type": "boolean"}},
+			"extra": {}
+		},
+		"required": ["id", "display_name", "created_at", "score", "tags", "address"],
+		"$defs": {
+			"address": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string"},
+					"zip": {"type": "string"}
+				},
+				"required": ["city"]
+			}
+		}
+	}`)
+
+	builder, err := NewStructFromJSONSchema(schema)
+	if err != nil {
+		t.Fatalf(`TestNewStructFromJSONSchema - expected not to have error got %#v`, err)
+	}
+
+	address := reflect.TypeOf(struct {
+		City string  `json:"city"`
+		Zip  *string `json:"zip,omitempty"`
+	}{})
+
+	expected := reflect.TypeOf(struct {
+		Id          int64     `json:"id"`
+		DisplayName string    `json:"display_name"`
+		CreatedAt   time.Time `json:"created_at"`
+		Score       *float64  `json:"score"`
+		Tags        []string  `json:"tags"`
+		Address     struct {
+			City string  `json:"city"`
+			Zip  *string `json:"zip,omitempty"`
+		} `json:"address"`
+		Labels map[string]bool `json:"labels,omitempty"`
+		Extra  json.RawMessage `json:"extra,omitempty"`
+	}{})
+
+	definition := reflect.TypeOf(builder.Build().New()).Elem()
+	if definition != expected {
+		t.Errorf(`TestNewStructFromJSONSchema - expected struct to be %s got %s`, expected, definition)
+	}
+
+	if definition.Field(5).Type != address {
+		t.Errorf(`TestNewStructFromJSONSchema - expected nested struct to be %s got %s`, address, definition.Field(5).Type)
+	}
+}
+
+func TestNewStructFromJSONSchema_Nested(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"address": {"$ref": "#/$defs/address"},
+			"billing": {"$ref": "#/$defs/address"},
+			"history": {"type": "array", "items": {"$ref": "#/$defs/address"}},
+			"byName": {"type": "object", "additionalProperties": {"$ref": "#/$defs/address"}}
+		},
+		"required": ["address", "history", "byName"],
+		"$defs": {
+			"address": {
+				"type": "object",
+				"properties": {
+					"city": {"type": "string"}
+				},
+				"required": ["city"]
+			}
+		}
+	}`)
+
+	builder, err := NewStructFromJSONSchema(schema)
+	if err != nil {
+		t.Fatalf(`TestNewStructFromJSONSchema_Nested - expected not to have error got %#v`, err)
+	}
+
+	for _, name := range []string{"Address.City", "Billing.City", "History.City", "ByName.City"} {
+		if field := builder.GetField(name); field == nil {
+			t.Errorf(`TestNewStructFromJSONSchema_Nested - expected field "%s" to be editable`, name)
+		}
+	}
+
+	builder.GetField("Address").Builder().AddField("Zip", "", `json:"zip"`)
+
+	address := reflect.TypeOf(struct {
+		City string `json:"city"`
+	}{})
+
+	expected := reflect.TypeOf(struct {
+		Address struct {
+			City string `json:"city"`
+			Zip  string `json:"zip"`
+		} `json:"address"`
+		Billing *struct {
+			City string `json:"city"`
+		} `json:"billing,omitempty"`
+		History []struct {
+			City string `json:"city"`
+		} `json:"history"`
+		ByName map[string]struct {
+			City string `json:"city"`
+		} `json:"byName"`
+	}{})
+
+	definition := builder.Build().Type()
+	if definition != expected {
+		t.Errorf(`TestNewStructFromJSONSchema_Nested - expected struct to be %s got %s`, expected, definition)
+	}
+
+	if definition.Field(2).Type.Elem() != address {
+		t.Errorf(`TestNewStructFromJSONSchema_Nested - expected nested struct to be %s got %s`, address, definition.Field(2).Type.Elem())
+	}
+}
+
+func TestNewStructFromJSONSchema_Errors(t *testing.T) {
+	schemas := []string{
+		`{"type": "string"}`,
+		`{"type": "object", "properties": {"next": {"$ref": "#"}}}`,
+		`{"type": "object", "properties": {"other": {"$ref": "#/$defs/missing"}}}`,
+		`{"type": "object", "properties": {"remote": {"$ref": "https://example.com/schema.json"}}}`,
+		`{"type": "object", "properties": {"-": {"type": "string"}}}`,
+		`{"type": 5}`,
+		`{"type": "object", "properties": {"a,b": {"type": "string"}}}`,
+		`{"type": "object", "properties": {"list": {"type": "array", "items": {"$ref": "#"}}}}`,
+		`{"type": "object", "properties": {"a\\"b": {"type": "string"}}}`,
+	}
+
+	for _, schema := range schemas {
+		if _, err := NewStructFromJSONSchema([]byte(schema)); err == nil {
+			t.Errorf(`TestNewStructFromJSONSchema_Errors - expected error for schema %s`, schema)
+		}
+	}
+}
+
+func TestNewStructFromJSONSchema_NameCollision(t *testing.T) {
+	schemas := []string{
+		`{"type": "object", "properties": {"user_id": {"type": "integer"}, "userId": {"type": "string"}}}`,
+		`{"type": "object", "properties": {"user": {"type": "object", "properties": {"user_id": {}, "userId": {}}}}}`,
+	}
+
+	for _, schema := range schemas {
+		_, err := NewStructFromJSONSchema([]byte(schema))
+		if err == nil {
+			t.Errorf(`TestNewStructFromJSONSchema_NameCollision - expected error for schema %s`, schema)
+			continue
+		}
+
+		if message := err.Error(); !strings.Contains(message, `"user_id"`) || !strings.Contains(message, `"userId"`) {
+			t.Errorf(`TestNewStructFromJSONSchema_NameCollision - expected error to name both properties got %#v`, message)
+		}
+	}
+}
+
+func TestNewStructFromJSONSchema_TagNames(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"@type": {"type": "string"},
+			"first name": {"type": "string"},
+			"日本": {"type": "string"}
+		},
+		"required": ["@type", "first name", "日本"]
+	}`)
+
+	builder, err := NewStructFromJSONSchema(schema)
+	if err != nil {
+		t.Fatalf(`TestNewStructFromJSONSchema_TagNames - expected not to have error got %#v`, err)
+	}
+
+	data, err := json.Marshal(builder.Build().New())
+	if err != nil {
+		t.Fatalf(`TestNewStructFromJSONSchema_TagNames - expected not to have error got %#v`, err)
+	}
+
+	if expected := `{"@type":"","first name":"","日本":""}`; string(data) != expected {
+		t.Errorf(`TestNewStructFromJSONSchema_TagNames - expected JSON to be %s got %s`, expected, data)
+	}
+}
+
+func TestJSONSchemaFieldName(t *testing.T) {
+	testCases := map[string]string{
+		"id":           "Id",
+		"display_name": "DisplayName",
+		"created-at":   "CreatedAt",
+		"already":      "Already",
+		"HTTPCode":     "HTTPCode",
+		"1st place":    "X1stPlace",
+		"日本":           "X日本",
+		"$":            "",
+	}
+
+	for property, expected := range testCases {
+		if name := jsonSchemaFieldName(property); name != expected {
+			t.Errorf(`TestJSONSchemaFieldName - expected name for "%s" to be "%s" got "%s"`, property, expected, name)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+)
+
+type (
+	// MergeOptions defines how MergeStructsWithOptions copies
+	// fields from existing structs into Builder.
+	MergeOptions struct {
+		// Unexported defines what to do with unexported fields.
+		Unexported UnexportedPolicy
+		// Conflicts defines what to do with fields whose names
+		// are already used by previously merged structs.
+		Conflicts ConflictPolicy
+		// Resolver, when it is set, decides about each conflict
+		// separately, instead of Conflicts.
+		Resolver func(conflict MergeConflict) ConflictPolicy
+		// Embedded defines what to do with embedded structs.
+		Embedded EmbeddedPolicy
+	}
+
+	// MergeConflict describes field whose name is already
+	// used by some of previously merged structs.
+	MergeConflict struct {
+		// Name is conflicting field's name.
+		Name string
+		// Index is position of merged value in arguments' list.
+		Index int
+		// Struct is type of merged value.
+		Struct reflect.Type
+		// Field is conflicting field of merged value.
+		Field reflect.StructField
+		// Existing is definition of already merged field.
+		Existing FieldConfig
+	}
+
+	// UnexportedPolicy defines how unexported fields are merged.
+	UnexportedPolicy int
+
+	// ConflictPolicy defines how fields with same names are merged.
+	ConflictPolicy int
+
+	// EmbeddedPolicy defines how embedded structs are merged.
+	EmbeddedPolicy int
+)
+
+const (
+	// UnexportedKeep keeps unexported fields together with
+	// theirs package path, so they stay unexported in dynamic struct.
+	UnexportedKeep UnexportedPolicy = iota
+	// UnexportedSkip leaves out all unexported fields.
+	UnexportedSkip
+	// UnexportedExport exports all unexported fields by
+	// capitalizing first letters of theirs names. Embedded fields
+	// of unexported types become regular named fields.
+	UnexportedExport
+)
+
+const (
+	// ConflictAppend adds all fields with same names, so
+	// Build and BuildE report them as duplicates.
+	ConflictAppend ConflictPolicy = iota
+	// ConflictFirstWins keeps already merged field and leaves out new one.
+	ConflictFirstWins
+	// ConflictLastWins replaces type and tag of already merged field
+	// with new ones, keeping its position.
+	ConflictLastWins
+	// ConflictError stops merging and returns an error.
+	ConflictError
+	// ConflictRenameWithPrefix adds new field with its name prefixed by
+	// name of merged struct's type, or by "Struct" and value's position
+	// for unnamed types. Renamed fields are always exported.
+	ConflictRenameWithPrefix
+)
+
+const (
+	// EmbeddedKeep copies embedded struct as a single anonymous field.
+	EmbeddedKeep EmbeddedPolicy = iota
+	// EmbeddedFlatten copies promoted fields of embedded structs as
+	// regular fields, following golang's rules for promoted fields:
+	// shallower fields shadow deeper ones, and ambiguous ones are left out.
+	// Embedded struct with unexported fields, like time.Time, is kept
+	// as a single anonymous field, so its type and methods are not lost.
+	EmbeddedFlatten
+	// EmbeddedExpand keeps embedded structs as anonymous fields, but
+	// defines theirs types by nested Builders, so theirs fields can be
+	// edited with paths, like builder.GetField("Embedded.Field").
+	// Expanded fields lose methods of original embedded types, and
+	// theirs types are unnamed, which is not valid golang code, so
+	// DynamicStruct.GoSource returns an error for them.
+	// Struct which embeds itself, directly or through other embedded
+	// structs, is kept as anonymous field of its original type where
+	// it repeats.
+	EmbeddedExpand
+)
+
+// MergeStructsWithOptions merges a list of existing instances of structs and
+// returns new instance of Builder interface, same as MergeStructs.
+// Fields are copied as it is defined by options. Each value can be
+// an instance of struct, a pointer to struct, which may be nil, or
+// reflect.Type of struct, so struct doesn't need to be instantiated.
+// It returns an error if some value is not a struct or pointer to struct.
+//
+// builder, err := dynamicstruct.MergeStructsWithOptions(dynamicstruct.MergeOptions{
+// 	Unexported: dynamicstruct.UnexportedSkip,
+// }, MyStructOne{}, MyStructTwo{})
+//
+func MergeStructsWithOptions(options MergeOptions, values ...interface{}) (Builder, error) {
+	builder := NewStruct().(*builderImpl)
+
+	for i, value := range values {
+		typeOf, valueOf := mergeSource(value)
+		if typeOf == nil || typeOf.Kind() != reflect.Struct {
+			if typ, ok := value.(reflect.Type); ok && typ != nil {
+				return nil, fmt.Errorf("MergeStructs: value #%d is not a struct type, but %s", i, typ)
+			}
+			return nil, fmt.Errorf("MergeStructs: value #%d is not a struct, but %T", i, value)
+		}
+
+		if err := mergeStruct(builder, options, i, typeOf, valueOf, map[reflect.Type]bool{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder, nil
+}
+
+func mergeSource(value interface{}) (reflect.Type, reflect.Value) {
+	if typeOf, ok := value.(reflect.Type); ok {
+		if typeOf != nil && typeOf.Kind() == reflect.Ptr {
+			typeOf = typeOf.Elem()
+		}
+		return typeOf, reflect.Value{}
+	}
+
+	valueOf := reflect.ValueOf(value)
+	if valueOf.Kind() == reflect.Ptr {
+		if valueOf.IsNil() {
+			return valueOf.Type().Elem(), reflect.Value{}
+		}
+		valueOf = valueOf.Elem()
+	}
+
+	if !valueOf.IsValid() {
+		return nil, valueOf
+	}
+
+	return valueOf.Type(), valueOf
+}
+
+func mergeStruct(builder *builderImpl, options MergeOptions, index int, typeOf reflect.Type, valueOf reflect.Value, expanding map[reflect.Type]bool) error {
+	expanding[typeOf] = true
+	defer delete(expanding, typeOf)
+
+	for _, ftyp := range mergeFields(typeOf, options.Embedded) {
+		var fval reflect.Value
+		if valueOf.IsValid() {
+			fval, _ = valueOf.FieldByIndexErr(ftyp.Index)
+		}
+
+		name, pkg, anonymous := ftyp.Name, ftyp.PkgPath, ftyp.Anonymous
+		var typ interface{} = declaredType{typ: ftyp.Type}
+		if fval.IsValid() && fval.CanInterface() && ftyp.Type.Kind() != reflect.Interface {
+			typ = fval.Interface()
+		}
+
+		if pkg != "" {
+			switch options.Unexported {
+			case UnexportedSkip:
+				continue
+			case UnexportedExport:
+				name, pkg, anonymous = exportName(name), "", false
+				if _, ok := typeOf.FieldByName(name); ok {
+					return fmt.Errorf(`MergeStructs: exported name "%s" of field "%s" is already used`, name, ftyp.Name)
+				}
+			}
+		}
+
+		nestedType := ftyp.Type
+		if nestedType.Kind() == reflect.Ptr {
+			nestedType = nestedType.Elem()
+		}
+
+		if ftyp.Anonymous && options.Embedded == EmbeddedExpand && nestedType.Kind() == reflect.Struct && !expanding[nestedType] {
+			nested := &nestedStruct{
+				builder: NewStruct().(*builderImpl),
+				kind:    ftyp.Type.Kind(),
+			}
+
+			if err := mergeStruct(nested.builder, options, index, nestedType, reflect.Indirect(fval), expanding); err != nil {
+				return err
+			}
+			typ = nested
+		}
+
+		if existing := builder.fieldIndex(name); existing >= 0 {
+			conflict := MergeConflict{
+				Name:     name,
+				Index:    index,
+				Struct:   typeOf,
+				Field:    ftyp,
+				Existing: builder.fields[existing],
+			}
+
+			policy := options.Conflicts
+			if options.Resolver != nil {
+				policy = options.Resolver(conflict)
+			}
+
+			switch policy {
+			case ConflictAppend:
+			case ConflictFirstWins:
+				continue
+			case ConflictLastWins:
+				field := builder.fields[existing]
+				field.pkg, field.typ, field.tag, field.anonymous = pkg, typ, string(ftyp.Tag), anonymous
+				continue
+			case ConflictError:
+				return fmt.Errorf(`MergeStructs: field "%s" of value #%d is already defined`, name, index)
+			case ConflictRenameWithPrefix:
+				name, pkg, anonymous = conflictPrefix(typeOf, index)+exportName(name), "", false
+				if _, ok := typeOf.FieldByName(name); ok || builder.HasField(name) {
+					return fmt.Errorf(`MergeStructs: prefixed name "%s" of field "%s" is already used`, name, ftyp.Name)
+				}
+			default:
+				return fmt.Errorf(`MergeStructs: unknown conflict policy %d for field "%s"`, policy, name)
+			}
+		}
+
+		builder.addField(name, pkg, typ, string(ftyp.Tag), anonymous)
+	}
+
+	return nil
+}
+
+func mergeFields(typeOf reflect.Type, policy EmbeddedPolicy) []reflect.StructField {
+	if policy != EmbeddedFlatten {
+		fields := make([]reflect.StructField, 0, typeOf.NumField())
+		for i := 0; i < typeOf.NumField(); i++ {
+			fields = append(fields, typeOf.Field(i))
+		}
+		return fields
+	}
+
+	var fields []reflect.StructField
+	var kept [][]int
+
+	for _, field := range reflect.VisibleFields(typeOf) {
+		if isPromotedFrom(field.Index, kept) {
+			continue
+		}
+
+		if field.Anonymous && isStructType(field.Type) {
+			if isFlattenable(field.Type) {
+				continue
+			}
+			kept = append(kept, field.Index)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// isFlattenable reports whether fields of embedded struct can be
+// copied as regular fields, which is when all of them are exported.
+// Structs embedded in it are checked on theirs own.
+func isFlattenable(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" && !(field.Anonymous && isStructType(field.Type)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPromotedFrom(index []int, embedded [][]int) bool {
+	for _, prefix := range embedded {
+		if len(index) > len(prefix) && reflect.DeepEqual(index[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isStructType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct
+}
+
+func conflictPrefix(typeOf reflect.Type, index int) string {
+	if typeOf.Name() != "" {
+		return exportName(typeOf.Name())
+	}
+	return fmt.Sprintf("Struct%d", index)
+}
+
+func exportName(name string) string {
+	first, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(first)) + name[size:]
+}
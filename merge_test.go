@@ -0,0 +1,453 @@
+package dynamicstruct
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mergeEmbedded struct {
+	Value int
+}
+
+type mergeThirdParty struct {
+	mergeEmbedded
+	ID      int `json:"id"`
+	secret  string
+	created time.Time
+	handler interface{}
+}
+
+func TestMergeStructs_Unexported(t *testing.T) {
+	type thirdParty struct {
+		ID      int `json:"id"`
+		secret  string
+		created time.Time
+		handler interface{}
+	}
+
+	dynamicStruct, err := MergeStructs(thirdParty{secret: "hidden"}).BuildE()
+	if err != nil {
+		t.Fatalf(`TestMergeStructs_Unexported - expected not to have error got %#v`, err)
+	}
+
+	definition := reflect.TypeOf(dynamicStruct.New()).Elem()
+	original := reflect.TypeOf(thirdParty{})
+
+	if definition.NumField() != original.NumField() {
+		t.Fatalf(`TestMergeStructs_Unexported - expected to have %d fields got %d`, original.NumField(), definition.NumField())
+	}
+
+	for i := 0; i < original.NumField(); i++ {
+		expected, field := original.Field(i), definition.Field(i)
+		if field.Name != expected.Name || field.PkgPath != expected.PkgPath || field.Type != expected.Type || field.Anonymous != expected.Anonymous {
+			t.Errorf(`TestMergeStructs_Unexported - expected field %#v got %#v`, expected, field)
+		}
+	}
+
+	_, err = MergeStructs(mergeThirdParty{}).BuildE()
+	if buildError, ok := err.(*BuildError); !ok || len(buildError.Fields) != 1 || buildError.Fields[0].Name != "mergeEmbedded" {
+		t.Errorf(`TestMergeStructs_Unexported - expected error for embedded field of unexported type got %#v`, err)
+	}
+}
+
+func TestMergeStructsWithOptions_Unexported(t *testing.T) {
+	builder, err := MergeStructsWithOptions(MergeOptions{
+		Unexported: UnexportedSkip,
+	}, mergeThirdParty{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Unexported - expected not to have error got %#v`, err)
+	}
+
+	if fields := len(builder.(*builderImpl).fields); fields != 1 || !builder.HasField("ID") {
+		t.Errorf(`TestMergeStructsWithOptions_Unexported - expected to have only field "ID" got %d fields`, fields)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{
+		Unexported: UnexportedExport,
+	}, mergeThirdParty{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Unexported - expected not to have error got %#v`, err)
+	}
+
+	for _, name := range []string{"MergeEmbedded", "ID", "Secret", "Created", "Handler"} {
+		if !builder.HasField(name) {
+			t.Errorf(`TestMergeStructsWithOptions_Unexported - expected to have field "%s"`, name)
+		}
+	}
+
+	definition := reflect.TypeOf(builder.Build().New()).Elem()
+	if field, _ := definition.FieldByName("Handler"); field.Type != reflect.TypeOf((*interface{})(nil)).Elem() {
+		t.Errorf(`TestMergeStructsWithOptions_Unexported - expected field "Handler" to be interface{} got %s`, field.Type)
+	}
+
+	type colliding struct {
+		name string
+		Name string
+	}
+
+	if _, err := MergeStructsWithOptions(MergeOptions{Unexported: UnexportedExport}, colliding{}); err == nil {
+		t.Error(`TestMergeStructsWithOptions_Unexported - expected error for colliding names`)
+	}
+}
+
+func TestMergeStructsWithOptions_Errors(t *testing.T) {
+	for _, value := range []interface{}{nil, 5, "text", []mergeEmbedded{}} {
+		if _, err := MergeStructsWithOptions(MergeOptions{}, value); err == nil {
+			t.Errorf(`TestMergeStructsWithOptions_Errors - expected error for %#v`, value)
+		}
+	}
+}
+
+type mergeUser struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type mergeOrder struct {
+	ID        string    `json:"order_id"`
+	Total     float64   `json:"total"`
+	CreatedAt time.Time `json:"order_created_at"`
+}
+
+func TestMergeStructsWithOptions_Conflicts(t *testing.T) {
+	tests := []struct {
+		policy   ConflictPolicy
+		expected []string
+		types    map[string]interface{}
+	}{
+		{
+			policy:   ConflictFirstWins,
+			expected: []string{"ID", "Name", "CreatedAt", "Total"},
+			types:    map[string]interface{}{"ID": 0},
+		},
+		{
+			policy:   ConflictLastWins,
+			expected: []string{"ID", "Name", "CreatedAt", "Total"},
+			types:    map[string]interface{}{"ID": ""},
+		},
+		{
+			policy:   ConflictRenameWithPrefix,
+			expected: []string{"ID", "Name", "CreatedAt", "MergeOrderID", "Total", "MergeOrderCreatedAt"},
+			types:    map[string]interface{}{"ID": 0, "MergeOrderID": ""},
+		},
+	}
+
+	for _, test := range tests {
+		builder, err := MergeStructsWithOptions(MergeOptions{Conflicts: test.policy}, mergeUser{}, &mergeOrder{})
+		if err != nil {
+			t.Fatalf(`TestMergeStructsWithOptions_Conflicts - expected not to have error got %#v`, err)
+		}
+
+		if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, test.expected) {
+			t.Errorf(`TestMergeStructsWithOptions_Conflicts - expected fields to be %#v got %#v`, test.expected, names)
+		}
+
+		definition := reflect.TypeOf(builder.Build().New()).Elem()
+		for name, typ := range test.types {
+			if field, _ := definition.FieldByName(name); field.Type != reflect.TypeOf(typ) {
+				t.Errorf(`TestMergeStructsWithOptions_Conflicts - expected field "%s" to be %T got %s`, name, typ, field.Type)
+			}
+		}
+	}
+
+	builder, _ := MergeStructsWithOptions(MergeOptions{Conflicts: ConflictLastWins}, mergeUser{}, mergeOrder{})
+	if tag := builder.GetField("CreatedAt").(*fieldConfigImpl).tag; tag != `json:"order_created_at"` {
+		t.Errorf(`TestMergeStructsWithOptions_Conflicts - expected tag of last struct got %#v`, tag)
+	}
+
+	_, err := MergeStructsWithOptions(MergeOptions{Conflicts: ConflictError}, mergeUser{}, mergeOrder{})
+	if err == nil {
+		t.Error(`TestMergeStructsWithOptions_Conflicts - expected error for conflicting field`)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{}, mergeUser{}, mergeOrder{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Conflicts - expected not to have error got %#v`, err)
+	}
+	if _, err := builder.BuildE(); err == nil {
+		t.Error(`TestMergeStructsWithOptions_Conflicts - expected build error for appended duplicates`)
+	}
+
+	anonymous := struct{ ID int64 }{}
+	builder, err = MergeStructsWithOptions(MergeOptions{Conflicts: ConflictRenameWithPrefix}, mergeUser{}, anonymous)
+	if err != nil || !builder.HasField("Struct1ID") {
+		t.Errorf(`TestMergeStructsWithOptions_Conflicts - expected field "Struct1ID" got %#v`, err)
+	}
+}
+
+func TestMergeStructsWithOptions_Resolver(t *testing.T) {
+	var conflicts []MergeConflict
+
+	builder, err := MergeStructsWithOptions(MergeOptions{
+		Conflicts: ConflictError,
+		Resolver: func(conflict MergeConflict) ConflictPolicy {
+			conflicts = append(conflicts, conflict)
+			if conflict.Name == "ID" {
+				return ConflictFirstWins
+			}
+			return ConflictLastWins
+		},
+	}, mergeUser{}, mergeOrder{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Resolver - expected not to have error got %#v`, err)
+	}
+
+	if len(conflicts) != 2 {
+		t.Fatalf(`TestMergeStructsWithOptions_Resolver - expected 2 conflicts got %#v`, conflicts)
+	}
+
+	conflict := conflicts[0]
+	if conflict.Name != "ID" || conflict.Index != 1 || conflict.Struct != reflect.TypeOf(mergeOrder{}) ||
+		conflict.Field.Tag != `json:"order_id"` || conflict.Existing != builder.GetField("ID") {
+		t.Errorf(`TestMergeStructsWithOptions_Resolver - unexpected conflict %#v`, conflict)
+	}
+
+	if typ := builder.GetField("ID").(*fieldConfigImpl).typ; typ != 0 {
+		t.Errorf(`TestMergeStructsWithOptions_Resolver - expected first field "ID" got %#v`, typ)
+	}
+	if tag := builder.GetField("CreatedAt").(*fieldConfigImpl).tag; tag != `json:"order_created_at"` {
+		t.Errorf(`TestMergeStructsWithOptions_Resolver - expected last field "CreatedAt" got %#v`, tag)
+	}
+
+	_, err = MergeStructsWithOptions(MergeOptions{
+		Resolver: func(conflict MergeConflict) ConflictPolicy {
+			return ConflictPolicy(100)
+		},
+	}, mergeUser{}, mergeOrder{})
+	if err == nil {
+		t.Error(`TestMergeStructsWithOptions_Resolver - expected error for unknown policy`)
+	}
+}
+
+type MergeAudit struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type MergeBase struct {
+	MergeAudit
+	ID   int    `json:"id"`
+	Note string `json:"note"`
+}
+
+type mergeDocument struct {
+	MergeBase
+	*MergeAudit `json:"audit"`
+	Note        string `json:"document_note"`
+	Title       string `json:"title"`
+}
+
+type mergeEvent struct {
+	time.Time
+	MergeAudit
+	Name string `json:"name"`
+}
+
+type mergeAmbiguousOne struct {
+	Value int
+	One   int
+}
+
+type mergeAmbiguousTwo struct {
+	Value string
+	Two   int
+}
+
+type mergeAmbiguous struct {
+	mergeAmbiguousOne
+	mergeAmbiguousTwo
+}
+
+func TestMergeStructsWithOptions_Flatten(t *testing.T) {
+	builder, err := MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedFlatten,
+	}, mergeDocument{MergeBase: MergeBase{ID: 5}})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Flatten - expected not to have error got %#v`, err)
+	}
+
+	expected := []string{"ID", "CreatedAt", "UpdatedAt", "Note", "Title"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	if tag := builder.GetField("Note").(*fieldConfigImpl).tag; tag != `json:"document_note"` {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected shallower field to win got %#v`, tag)
+	}
+
+	builder.GetField("ID").SetTag(`json:"identifier"`)
+
+	definition := reflect.TypeOf(builder.Build().New()).Elem()
+	if field, _ := definition.FieldByName("ID"); field.Tag != `json:"identifier"` || field.Anonymous {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected promoted field to be editable got %#v`, field)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedFlatten,
+	}, MergeBase{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Flatten - expected not to have error got %#v`, err)
+	}
+
+	expected = []string{"CreatedAt", "UpdatedAt", "ID", "Note"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedFlatten,
+	}, mergeAmbiguous{}, mergeThirdParty{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Flatten - expected not to have error got %#v`, err)
+	}
+
+	expected = []string{"One", "Two", "Value", "ID", "secret", "created", "handler"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	if _, err := builder.BuildE(); err != nil {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected not to have error got %#v`, err)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedFlatten,
+	}, mergeEvent{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Flatten - expected not to have error got %#v`, err)
+	}
+
+	expected = []string{"Time", "CreatedAt", "UpdatedAt", "Name"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	definition = reflect.TypeOf(builder.Build().New()).Elem()
+	if field := definition.Field(0); field.Type != reflect.TypeOf(time.Time{}) || !field.Anonymous {
+		t.Errorf(`TestMergeStructsWithOptions_Flatten - expected time.Time to be kept as embedded got %#v`, field)
+	}
+}
+
+func TestMergeStructsWithOptions_Expand(t *testing.T) {
+	builder, err := MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedExpand,
+	}, MergeBase{ID: 5})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Expand - expected not to have error got %#v`, err)
+	}
+
+	expected := []string{"MergeAudit", "ID", "Note"}
+	if names := testBuilderFieldNames(builder); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestMergeStructsWithOptions_Expand - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	if !builder.HasField("MergeAudit.CreatedAt") || builder.HasField("MergeAudit.ID") || builder.HasField("ID.Value") {
+		t.Error(`TestMergeStructsWithOptions_Expand - expected paths to reach only fields of embedded struct`)
+	}
+
+	builder.GetField("MergeAudit.CreatedAt").SetTag(`json:"created"`)
+	builder.GetField("MergeAudit").(*fieldConfigImpl).typ.(*nestedStruct).builder.AddField("DeletedAt", time.Time{}, "")
+
+	definition := reflect.TypeOf(builder.Build().New()).Elem()
+
+	embedded, _ := definition.FieldByName("MergeAudit")
+	if !embedded.Anonymous || embedded.Type.Kind() != reflect.Struct || embedded.Type.NumField() != 3 {
+		t.Errorf(`TestMergeStructsWithOptions_Expand - expected embedded struct with 3 fields got %#v`, embedded)
+	}
+
+	if field, _ := definition.FieldByName("CreatedAt"); field.Tag != `json:"created"` || len(field.Index) != 2 {
+		t.Errorf(`TestMergeStructsWithOptions_Expand - expected promoted field with changed tag got %#v`, field)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{
+		Embedded: EmbeddedExpand,
+	}, mergeDocument{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_Expand - expected not to have error got %#v`, err)
+	}
+
+	if !builder.HasField("MergeBase.MergeAudit.UpdatedAt") {
+		t.Error(`TestMergeStructsWithOptions_Expand - expected to reach deeply embedded field`)
+	}
+
+	definition = reflect.TypeOf(builder.Build().New()).Elem()
+	if field, _ := definition.FieldByName("MergeAudit"); field.Type.Kind() != reflect.Ptr || field.Tag != `json:"audit"` {
+		t.Errorf(`TestMergeStructsWithOptions_Expand - expected embedded pointer got %#v`, field)
+	}
+
+	builder.GetField("MergeBase.MergeAudit.UpdatedAt").SetType(nil)
+
+	_, err = builder.BuildE()
+	if buildError, ok := err.(*BuildError); !ok || len(buildError.Fields) != 1 || buildError.Fields[0].Name != "MergeBase" {
+		t.Errorf(`TestMergeStructsWithOptions_Expand - expected error for nested field got %#v`, err)
+	}
+}
+
+func TestMergeStructs_Interfaces(t *testing.T) {
+	type handlers struct {
+		Reader  io.Reader
+		Err     error
+		Any     interface{}
+		Missing io.Writer
+	}
+
+	dynamicStruct := MergeStructs(handlers{
+		Reader: strings.NewReader("text"),
+		Err:    io.EOF,
+		Any:    5,
+	}).Build()
+
+	original := reflect.TypeOf(handlers{})
+	for i, field := range dynamicStruct.Fields() {
+		if field.Type != original.Field(i).Type {
+			t.Errorf(`TestMergeStructs_Interfaces - expected field "%s" to be %s got %s`, field.Name, original.Field(i).Type, field.Type)
+		}
+	}
+}
+
+type MergeNode struct {
+	*MergeNode
+	Value int
+}
+
+type MergeParent struct {
+	*MergeChild
+	Name string
+}
+
+type MergeChild struct {
+	*MergeParent
+	Age int
+}
+
+func TestMergeStructsWithOptions_ExpandCycle(t *testing.T) {
+	builder, err := MergeStructsWithOptions(MergeOptions{Embedded: EmbeddedExpand}, MergeNode{})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_ExpandCycle - expected not to have error got %#v`, err)
+	}
+
+	if field := builder.GetField("MergeNode"); field.Builder() != nil || field.Type() != reflect.TypeOf(&MergeNode{}) {
+		t.Errorf(`TestMergeStructsWithOptions_ExpandCycle - expected self-embedded field to keep its type got %#v`, field)
+	}
+
+	builder, err = MergeStructsWithOptions(MergeOptions{Embedded: EmbeddedExpand}, &MergeParent{MergeChild: &MergeChild{}})
+	if err != nil {
+		t.Fatalf(`TestMergeStructsWithOptions_ExpandCycle - expected not to have error got %#v`, err)
+	}
+
+	if !builder.HasField("MergeChild.Age") {
+		t.Error(`TestMergeStructsWithOptions_ExpandCycle - expected embedded child to be expanded`)
+	}
+
+	if field := builder.GetField("MergeChild.MergeParent"); field.Builder() != nil || field.Type() != reflect.TypeOf(&MergeParent{}) {
+		t.Errorf(`TestMergeStructsWithOptions_ExpandCycle - expected repeated parent to keep its type got %#v`, field)
+	}
+
+	if _, err := builder.BuildE(); err != nil {
+		t.Errorf(`TestMergeStructsWithOptions_ExpandCycle - expected not to have error got %#v`, err)
+	}
+}
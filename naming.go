@@ -0,0 +1,136 @@
+package dynamicstruct
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+type (
+	// NamingStrategy converts field's name into a name used in tag.
+	NamingStrategy func(name string) string
+
+	// TagRule defines how Builder generates key of field tags,
+	// for fields which don't have that key already.
+	TagRule struct {
+		// Key is tag's key, like json or db.
+		Key string
+		// Strategy converts field's name into tag's value.
+		// Nil Strategy keeps field's name as it is.
+		Strategy NamingStrategy
+		// OmitEmpty adds omitempty option to tag's value.
+		OmitEmpty bool
+	}
+)
+
+var (
+	// SnakeCase converts "HTTPServerID" into "http_server_id".
+	SnakeCase NamingStrategy = func(name string) string {
+		return strings.ToLower(strings.Join(splitName(name), "_"))
+	}
+
+	// ScreamingSnakeCase converts "HTTPServerID" into "HTTP_SERVER_ID".
+	ScreamingSnakeCase NamingStrategy = func(name string) string {
+		return strings.ToUpper(strings.Join(splitName(name), "_"))
+	}
+
+	// KebabCase converts "HTTPServerID" into "http-server-id".
+	KebabCase NamingStrategy = func(name string) string {
+		return strings.ToLower(strings.Join(splitName(name), "-"))
+	}
+
+	// CamelCase converts "HTTPServerID" into "httpServerId".
+	CamelCase NamingStrategy = func(name string) string {
+		words := splitName(name)
+		for i, word := range words {
+			word = strings.ToLower(word)
+			if i > 0 {
+				word = exportName(word)
+			}
+			words[i] = word
+		}
+		return strings.Join(words, "")
+	}
+)
+
+func (b *builderImpl) GenerateTags(rules ...TagRule) Builder {
+	for _, rule := range rules {
+		replaced := false
+		for i := range b.tagRules {
+			if b.tagRules[i].Key == rule.Key {
+				b.tagRules[i] = rule
+				replaced = true
+			}
+		}
+		if !replaced {
+			b.tagRules = append(b.tagRules, rule)
+		}
+	}
+	return b
+}
+
+func (b *builderImpl) fieldTag(field *fieldConfigImpl) string {
+	if len(b.tagRules) == 0 || field.anonymous || !token.IsExported(field.name) {
+		return field.tag
+	}
+
+	tags, err := ParseTags(field.tag)
+	if err != nil {
+		return field.tag
+	}
+
+	for _, rule := range b.tagRules {
+		if _, ok := tags.Get(rule.Key); ok {
+			continue
+		}
+
+		value := field.name
+		if rule.Strategy != nil {
+			value = rule.Strategy(value)
+		}
+		if rule.OmitEmpty {
+			value += ",omitempty"
+		}
+
+		tags.Set(rule.Key, value)
+	}
+
+	return tags.String()
+}
+
+func splitName(name string) []string {
+	var words []string
+
+	runes := []rune(name)
+	start := 0
+
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && runes[i] != '_' && runes[i] != '-' && !startsWord(runes, i) {
+			continue
+		}
+
+		if start < i {
+			words = append(words, string(runes[start:i]))
+		}
+
+		start = i
+		if i < len(runes) && (runes[i] == '_' || runes[i] == '-') {
+			start++
+		}
+	}
+
+	return words
+}
+
+func startsWord(runes []rune, i int) bool {
+	if i == 0 || !unicode.IsUpper(runes[i]) {
+		return false
+	}
+
+	previous := runes[i-1]
+	if unicode.IsLower(previous) || unicode.IsDigit(previous) {
+		return true
+	}
+
+	return unicode.IsUpper(previous) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
@@ -0,0 +1,81 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamingStrategy(t *testing.T) {
+	tests := []struct {
+		name      string
+		snake     string
+		screaming string
+		kebab     string
+		camel     string
+	}{
+		{name: "Name", snake: "name", screaming: "NAME", kebab: "name", camel: "name"},
+		{name: "FirstName", snake: "first_name", screaming: "FIRST_NAME", kebab: "first-name", camel: "firstName"},
+		{name: "HTTPServerID", snake: "http_server_id", screaming: "HTTP_SERVER_ID", kebab: "http-server-id", camel: "httpServerId"},
+		{name: "Address2Line", snake: "address2_line", screaming: "ADDRESS2_LINE", kebab: "address2-line", camel: "address2Line"},
+		{name: "Already_Snake-Kebab", snake: "already_snake_kebab", screaming: "ALREADY_SNAKE_KEBAB", kebab: "already-snake-kebab", camel: "alreadySnakeKebab"},
+		{name: "ID", snake: "id", screaming: "ID", kebab: "id", camel: "id"},
+		{name: "ÜberName", snake: "über_name", screaming: "ÜBER_NAME", kebab: "über-name", camel: "überName"},
+	}
+
+	for _, test := range tests {
+		if value := SnakeCase(test.name); value != test.snake {
+			t.Errorf(`TestNamingStrategy - expected snake case %#v got %#v`, test.snake, value)
+		}
+		if value := ScreamingSnakeCase(test.name); value != test.screaming {
+			t.Errorf(`TestNamingStrategy - expected screaming snake case %#v got %#v`, test.screaming, value)
+		}
+		if value := KebabCase(test.name); value != test.kebab {
+			t.Errorf(`TestNamingStrategy - expected kebab case %#v got %#v`, test.kebab, value)
+		}
+		if value := CamelCase(test.name); value != test.camel {
+			t.Errorf(`TestNamingStrategy - expected camel case %#v got %#v`, test.camel, value)
+		}
+	}
+}
+
+func TestBuilderImpl_GenerateTags(t *testing.T) {
+	builder := NewStruct().
+		AddField("FirstName", "", `json:"name" validate:"required"`).
+		GenerateTags(
+			TagRule{Key: "json", Strategy: SnakeCase},
+			TagRule{Key: "db", Strategy: SnakeCase},
+			TagRule{Key: "xml"},
+		).
+		GenerateTags(TagRule{Key: "json", Strategy: CamelCase, OmitEmpty: true}).
+		AddField("HTTPServerID", 0, "").
+		AddField("Invalid", 0, `invalid`).(*builderImpl)
+	builder.addField("Embedded", "", struct{}{}, "", true)
+	builder.addField("hidden", "example.com/pkg", 0, "", false)
+
+	expected := map[string]reflect.StructTag{
+		"FirstName":    `json:"name" validate:"required" db:"first_name" xml:"FirstName"`,
+		"HTTPServerID": `json:"httpServerId,omitempty" db:"http_server_id" xml:"HTTPServerID"`,
+		"Invalid":      `invalid`,
+		"Embedded":     ``,
+		"hidden":       ``,
+	}
+
+	definition := reflect.TypeOf(builder.Build().New()).Elem()
+	for name, tag := range expected {
+		if field, _ := definition.FieldByName(name); field.Tag != tag {
+			t.Errorf(`TestBuilderImpl_GenerateTags - expected tag of "%s" to be %#v got %#v`, name, tag, field.Tag)
+		}
+	}
+
+	if tag := builder.GetField("HTTPServerID").(*fieldConfigImpl).tag; tag != "" {
+		t.Errorf(`TestBuilderImpl_GenerateTags - expected field's own tag to stay unchanged got %#v`, tag)
+	}
+
+	schema, err := NewSchema(builder)
+	if err != nil {
+		t.Fatalf(`TestBuilderImpl_GenerateTags - expected not to have error got %#v`, err)
+	}
+	if tag := schema.Fields[1].Tag; tag != string(expected["HTTPServerID"]) {
+		t.Errorf(`TestBuilderImpl_GenerateTags - expected schema's tag to be %#v got %#v`, expected["HTTPServerID"], tag)
+	}
+}
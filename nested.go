@@ -0,0 +1,113 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"strings"
+)
+
+// nestedStruct is field's type defined by another Builder.
+// Its definition is built again whenever parent struct is built,
+// so all changes in nested Builder are visible in parent's definition.
+type nestedStruct struct {
+	builder *builderImpl
+	kind    reflect.Kind
+	key     reflect.Type
+}
+
+func (b *builderImpl) AddStructField(name string, define func(builder Builder), tag string) Builder {
+	return b.AddField(name, newNestedStruct(reflect.Struct, nil, define), tag)
+}
+
+func (b *builderImpl) AddSliceOfStructsField(name string, define func(builder Builder), tag string) Builder {
+	return b.AddField(name, newNestedStruct(reflect.Slice, nil, define), tag)
+}
+
+func (b *builderImpl) AddMapOfStructsField(name string, key interface{}, define func(builder Builder), tag string) Builder {
+	return b.AddField(name, newNestedMap(key, define), tag)
+}
+
+func newNestedMap(key interface{}, define func(builder Builder)) interface{} {
+	keyType := typeOfDescriptor(key)
+	if keyType == nil || !keyType.Comparable() {
+		return invalidType("map key type is not comparable")
+	}
+
+	return newNestedStruct(reflect.Map, keyType, define)
+}
+
+func newNestedStruct(kind reflect.Kind, key reflect.Type, define func(builder Builder)) *nestedStruct {
+	nested := &nestedStruct{
+		builder: NewStruct().(*builderImpl),
+		kind:    kind,
+		key:     key,
+	}
+
+	if define != nil {
+		define(nested.builder)
+	}
+
+	return nested
+}
+
+func (n *nestedStruct) reflectType() reflect.Type {
+	typ, _ := n.build()
+	return typ
+}
+
+func (n *nestedStruct) wrap(typ reflect.Type) reflect.Type {
+	switch n.kind {
+	case reflect.Ptr:
+		return reflect.PtrTo(typ)
+	case reflect.Slice:
+		return reflect.SliceOf(typ)
+	case reflect.Map:
+		return reflect.MapOf(n.key, typ)
+	default:
+		return typ
+	}
+}
+
+func (n *nestedStruct) build() (reflect.Type, []string) {
+	dynamicStruct, err := n.builder.BuildE()
+	if err == nil {
+		return n.wrap(dynamicStruct.(*dynamicStructImpl).definition), nil
+	}
+
+	var reasons []string
+
+	if buildError, ok := err.(*BuildError); ok {
+		for _, field := range buildError.Fields {
+			reasons = append(reasons, "nested "+field.Error())
+		}
+		if buildError.Reason != "" {
+			reasons = append(reasons, "nested struct: "+buildError.Reason)
+		}
+	}
+
+	return nil, reasons
+}
+
+func (b *builderImpl) getFieldByPath(path string) *fieldConfigImpl {
+	names := strings.Split(path, ".")
+
+	builder := b
+	for i, name := range names {
+		index := builder.fieldIndex(name)
+		if index < 0 {
+			return nil
+		}
+
+		field := builder.fields[index]
+		if i == len(names)-1 {
+			return field
+		}
+
+		nested, ok := field.typ.(*nestedStruct)
+		if !ok {
+			return nil
+		}
+		builder = nested.builder
+	}
+
+	return nil
+}
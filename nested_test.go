@@ -0,0 +1,132 @@
+package dynamicstruct
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuilderImpl_AddStructField(t *testing.T) {
+	builder := NewStruct().
+		AddField("ID", 0, `json:"id"`).
+		AddStructField("Address", func(nested Builder) {
+			nested.
+				AddField("City", "", `json:"city"`).
+				AddStructField("Geo", func(nested Builder) {
+					nested.AddField("Lat", 0.0, `json:"lat"`)
+				}, `json:"geo"`)
+		}, `json:"address"`).
+		AddSliceOfStructsField("Items", func(nested Builder) {
+			nested.AddField("Name", "", `json:"name"`)
+		}, `json:"items"`).
+		AddMapOfStructsField("Labels", "", func(nested Builder) {
+			nested.AddField("Value", "", `json:"value"`)
+		}, `json:"labels"`)
+
+	definition := builder.Build().Type()
+
+	expected := map[string]reflect.Kind{
+		"Address": reflect.Struct,
+		"Items":   reflect.Slice,
+		"Labels":  reflect.Map,
+	}
+	for name, kind := range expected {
+		if field, _ := definition.FieldByName(name); field.Type.Kind() != kind {
+			t.Errorf(`TestBuilderImpl_AddStructField - expected field "%s" of kind %s got %s`, name, kind, field.Type)
+		}
+	}
+
+	if field, _ := definition.FieldByName("Labels"); field.Type.Key() != reflect.TypeOf("") || field.Type.Elem().Field(0).Name != "Value" {
+		t.Errorf(`TestBuilderImpl_AddStructField - expected map of nested structs got %s`, field.Type)
+	}
+
+	instance := builder.Build().New()
+	data := `{"id":1,"address":{"city":"Berlin","geo":{"lat":52.5}},"items":[{"name":"one"}],"labels":{"key":{"value":"label"}}}`
+	if err := json.Unmarshal([]byte(data), instance); err != nil {
+		t.Fatalf(`TestBuilderImpl_AddStructField - expected not to have error got %#v`, err)
+	}
+
+	reader := NewReader(instance)
+	if reader.GetField("Address.Geo.Lat").Float64() != 52.5 || reader.GetField("Items[0].Name").String() != "one" ||
+		reader.GetField("Labels[key].Value").String() != "label" {
+		t.Errorf(`TestBuilderImpl_AddStructField - expected decoded nested values got %#v`, instance)
+	}
+
+	address := builder.GetField("Address").Builder()
+	if address == nil || !address.HasField("Geo") {
+		t.Fatalf(`TestBuilderImpl_AddStructField - expected nested builder got %#v`, address)
+	}
+
+	address.AddField("Zip", "", `json:"zip"`)
+	builder.GetField("Address.Geo").Builder().AddField("Lng", 0.0, `json:"lng"`)
+	builder.GetField("Items.Name").SetTag(`json:"title"`)
+
+	definition = builder.Build().Type()
+	if field, _ := definition.FieldByName("Address"); field.Type.NumField() != 3 || field.Type.Field(1).Type.NumField() != 2 {
+		t.Errorf(`TestBuilderImpl_AddStructField - expected edited nested struct got %s`, field.Type)
+	}
+	if field, _ := definition.FieldByName("Items"); field.Type.Elem().Field(0).Tag != `json:"title"` {
+		t.Errorf(`TestBuilderImpl_AddStructField - expected edited tag got %s`, field.Type.Elem().Field(0).Tag)
+	}
+
+	if builder.GetField("ID").Builder() != nil {
+		t.Error(`TestBuilderImpl_AddStructField - expected nil builder for regular field`)
+	}
+
+	if definition := NewStruct().AddStructField("Empty", nil, "").Build().Type(); definition.Field(0).Type.NumField() != 0 {
+		t.Errorf(`TestBuilderImpl_AddStructField - expected empty nested struct got %s`, definition)
+	}
+}
+
+func TestBuilderImpl_AddStructField_Errors(t *testing.T) {
+	_, err := NewStruct().
+		AddStructField("Address", func(nested Builder) {
+			nested.AddField("city", "", "")
+		}, "").
+		AddMapOfStructsField("Labels", []string{}, func(nested Builder) {
+			nested.AddField("Value", "", "")
+		}, "").
+		BuildE()
+
+	buildError, ok := err.(*BuildError)
+	if !ok || len(buildError.Fields) != 2 {
+		t.Fatalf(`TestBuilderImpl_AddStructField_Errors - expected 2 errors got %#v`, err)
+	}
+
+	if reason := buildError.Fields[0].Reason; !strings.HasPrefix(reason, `nested field #0 "city"`) {
+		t.Errorf(`TestBuilderImpl_AddStructField_Errors - expected nested error got %#v`, reason)
+	}
+	if reason := buildError.Fields[1].Reason; reason != "map key type is not comparable" {
+		t.Errorf(`TestBuilderImpl_AddStructField_Errors - expected key error got %#v`, reason)
+	}
+}
+
+func TestBuilderImpl_AddStructField_Wrappers(t *testing.T) {
+	define := func(nested Builder) {
+		nested.AddField("Name", "", "")
+	}
+
+	for _, builder := range []Builder{NewStruct().Immutable(), Synchronized(NewStruct())} {
+		builder = builder.
+			AddStructField("Struct", define, "").
+			AddSliceOfStructsField("Slice", define, "").
+			AddMapOfStructsField("Map", 0, define, "")
+
+		nested := builder.GetField("Struct").Builder()
+		nested.AddField("Other", 0, "")
+
+		definition := builder.Build().Type()
+		if definition.NumField() != 3 || definition.Field(2).Type.Key() != reflect.TypeOf(0) {
+			t.Errorf(`TestBuilderImpl_AddStructField_Wrappers - expected nested fields got %s`, definition)
+		}
+
+		fields := definition.Field(0).Type.NumField()
+		if _, ok := builder.(*immutableBuilder); ok && fields != 1 {
+			t.Errorf(`TestBuilderImpl_AddStructField_Wrappers - expected immutable nested struct got %d fields`, fields)
+		}
+		if _, ok := builder.(*syncBuilder); ok && fields != 2 {
+			t.Errorf(`TestBuilderImpl_AddStructField_Wrappers - expected edited nested struct got %d fields`, fields)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type pathSegment struct {
+	name    string
+	isIndex bool
+}
+
+func isPath(name string) bool {
+	return strings.ContainsAny(name, ".[")
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+
+	rest := path
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf(`missing "]" in path "%s"`, path)
+			}
+			segments = append(segments, pathSegment{
+				name:    rest[1:end],
+				isIndex: true,
+			})
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "."):
+			if len(segments) == 0 {
+				return nil, fmt.Errorf(`unexpected "." in path "%s"`, path)
+			}
+			rest = rest[1:]
+			fallthrough
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf(`empty field's name in path "%s"`, path)
+			}
+			segments = append(segments, pathSegment{
+				name: rest[:end],
+			})
+			rest = rest[end:]
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf(`empty path "%s"`, path)
+	}
+
+	return segments, nil
+}
+
+func parsePathKey(key string, typ reflect.Type) (reflect.Value, error) {
+	value := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.String:
+		value.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number, err := strconv.ParseUint(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetUint(number)
+	case reflect.Float32, reflect.Float64:
+		number, err := strconv.ParseFloat(key, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetFloat(number)
+	case reflect.Bool:
+		boolean, err := strconv.ParseBool(key)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetBool(boolean)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported key type %s", typ)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,41 @@
+package dynamicstruct
+
+import (
+	"testing"
+)
+
+func TestDynamicStructImpl_AcquireRelease(t *testing.T) {
+	dynamicStruct := NewStruct().
+		AddField("Integer", 0, "").
+		AddField("Text", "", "").
+		Build()
+
+	value := dynamicStruct.Acquire()
+
+	writer, err := NewWriter(value)
+	if err != nil {
+		t.Fatalf(`TestDynamicStructImpl_AcquireRelease - expected not to have error got %#v`, err)
+	}
+	_ = writer.SetInt("Integer", 123)
+	_ = writer.SetString("Text", "text")
+
+	if err := dynamicStruct.Release(value); err != nil {
+		t.Errorf(`TestDynamicStructImpl_AcquireRelease - expected not to have error got %#v`, err)
+	}
+
+	for i := 0; i < 10; i++ {
+		reader := NewReader(dynamicStruct.Acquire())
+		if reader.GetField("Integer").Int() != 0 || reader.GetField("Text").String() != "" {
+			t.Errorf(`TestDynamicStructImpl_AcquireRelease - expected acquired instance to be zeroed got %#v`, reader.GetValue())
+		}
+	}
+
+	other := NewStruct().AddField("Integer", 0, "").Build()
+	var nilPointer *testStructOne
+
+	for _, foreign := range []interface{}{other.New(), nil, 5, nilPointer, &testStructOne{}} {
+		if err := dynamicStruct.Release(foreign); err == nil {
+			t.Errorf(`TestDynamicStructImpl_AcquireRelease - expected error for %#v`, foreign)
+		}
+	}
+}
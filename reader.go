@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 )
 
@@ -12,6 +13,7 @@ type (
 	// access struct's fields' values, reads and provides theirs values.
 	Reader interface {
 		// HasField checks if struct instance has a field with a given name.
+		// Name can be a path to nested field, as it is described for GetField.
 		//
 		// if reader.HasField("SomeFloatField") { ...
 		//
@@ -19,11 +21,16 @@ type (
 		// GetField returns struct instance's field value.
 		// If there is no such field, it returns nil.
 		// Usable to edit existing struct's field.
+		// Name can be a path to nested field, with dots between fields' names
+		// and brackets for elements of slices, arrays and maps. Pointers,
+		// interfaces and embedded structs are resolved on the way.
 		//
 		// field := reader.GetField("SomeFloatField")
+		// nested := reader.GetField("SomeStruct.Items[0].Labels[key]")
 		//
 		GetField(name string) Field
-		// GetAllFields returns a list of all struct instance's fields.
+		// GetAllFields returns a list of all struct instance's fields,
+		// in the same order as they are declared in struct.
 		//
 		// for _, field := range reader.GetAllFields() { ...
 		//
@@ -62,6 +69,28 @@ type (
 		// name := field.GetName()
 		//
 		Name() string
+		// Index returns field's position in struct which contains it.
+		// For element of slice or array it returns element's index,
+		// and for element of map it returns -1.
+		//
+		// index := reader.GetField("SomeField").Index()
+		//
+		Index() int
+		// Offset returns field's offset in bytes within struct which contains it.
+		//
+		// offset := reader.GetField("SomeField").Offset()
+		//
+		Offset() uintptr
+		// Type returns field's type, as it is declared in struct.
+		//
+		// typ := reader.GetField("SomeField").Type()
+		//
+		Type() reflect.Type
+		// Tag returns field's tag.
+		//
+		// json := reader.GetField("SomeField").Tag().Get("json")
+		//
+		Tag() reflect.StructTag
 		// PointerInt returns a pointer for instance of int type.
 		// It panics if field's value can't be casted to desired type.
 		//
@@ -242,6 +271,105 @@ type (
 		// dateTime := reader.GetField("SomeField").Time()
 		//
 		Time() time.Time
+		// IntE returns an instance of int type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").IntE()
+		//
+		IntE() (int, error)
+		// Int8E returns an instance of int8 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Int8E()
+		//
+		Int8E() (int8, error)
+		// Int16E returns an instance of int16 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Int16E()
+		//
+		Int16E() (int16, error)
+		// Int32E returns an instance of int32 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Int32E()
+		//
+		Int32E() (int32, error)
+		// Int64E returns an instance of int64 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Int64E()
+		//
+		Int64E() (int64, error)
+		// UintE returns an instance of uint type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// unsigned, err := reader.GetField("SomeField").UintE()
+		//
+		UintE() (uint, error)
+		// Uint8E returns an instance of uint8 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// unsigned, err := reader.GetField("SomeField").Uint8E()
+		//
+		Uint8E() (uint8, error)
+		// Uint16E returns an instance of uint16 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// unsigned, err := reader.GetField("SomeField").Uint16E()
+		//
+		Uint16E() (uint16, error)
+		// Uint32E returns an instance of uint32 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// unsigned, err := reader.GetField("SomeField").Uint32E()
+		//
+		Uint32E() (uint32, error)
+		// Uint64E returns an instance of uint64 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// unsigned, err := reader.GetField("SomeField").Uint64E()
+		//
+		Uint64E() (uint64, error)
+		// Float32E returns an instance of float32 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Float32E()
+		//
+		Float32E() (float32, error)
+		// Float64E returns an instance of float64 type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// number, err := reader.GetField("SomeField").Float64E()
+		//
+		Float64E() (float64, error)
+		// StringE returns an instance of string type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// text, err := reader.GetField("SomeField").StringE()
+		//
+		StringE() (string, error)
+		// BoolE returns an instance of bool type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// boolean, err := reader.GetField("SomeField").BoolE()
+		//
+		BoolE() (bool, error)
+		// TimeE returns an instance of time.Time{} type.
+		// It returns an error if field's value is nil or can't be casted to desired type.
+		//
+		// dateTime, err := reader.GetField("SomeField").TimeE()
+		//
+		TimeE() (time.Time, error)
+		// As sets field's value into variable which pointer is passed as an argument.
+		// Value is converted to variable's type when it is possible without loss,
+		// dereferenced when field holds a pointer, or pointer is allocated when
+		// variable expects one. It returns an error if it is not possible.
+		//
+		// var number int
+		// err := reader.GetField("SomeField").As(&number)
+		//
+		As(target interface{}) error
 		// Interface returns an interface which represents field's value.
 		// Useful for casting value into desired type.
 		//
@@ -251,20 +379,40 @@ type (
 	}
 
 	readImpl struct {
-		fields map[string]fieldImpl
-		value  interface{}
+		fields  map[string]fieldImpl
+		names   []string
+		value   interface{}
+		convert bool
 	}
 
 	fieldImpl struct {
-		field reflect.StructField
-		value reflect.Value
+		field   reflect.StructField
+		value   reflect.Value
+		convert bool
 	}
 )
 
 // NewReader reads struct instance and provides instance of
 // Reader interface to give possibility to read all fields' values.
 func NewReader(value interface{}) Reader {
+	return newReader(value, false)
+}
+
+// NewConvertingReader reads struct instance and provides instance of
+// Reader interface, same as NewReader. Fields' getters of this reader
+// convert values between all numeric types and strings, and strings to
+// booleans. Conversion which would overflow or lose precision is reported
+// as an error by getters with E suffix, and as panic by others.
+//
+// number, err := dynamicstruct.NewConvertingReader(instance).GetField("Text").IntE()
+//
+func NewConvertingReader(value interface{}) Reader {
+	return newReader(value, true)
+}
+
+func newReader(value interface{}, convert bool) Reader {
 	fields := map[string]fieldImpl{}
+	var names []string
 
 	valueOf := reflect.Indirect(reflect.ValueOf(value))
 	typeOf := valueOf.Type()
@@ -273,35 +421,69 @@ func NewReader(value interface{}) Reader {
 		for i := 0; i < valueOf.NumField(); i++ {
 			field := typeOf.Field(i)
 			fields[field.Name] = fieldImpl{
-				field: field,
-				value: valueOf.Field(i),
+				field:   field,
+				value:   valueOf.Field(i),
+				convert: convert,
 			}
+			names = append(names, field.Name)
 		}
 	}
 
 	return readImpl{
-		fields: fields,
-		value:  value,
+		fields:  fields,
+		names:   names,
+		value:   value,
+		convert: convert,
 	}
 }
 
 func (r readImpl) HasField(name string) bool {
-	_, ok := r.fields[name]
+	_, ok := r.getField(name)
 	return ok
 }
 
 func (r readImpl) GetField(name string) Field {
-	if !r.HasField(name) {
+	field, ok := r.getField(name)
+	if !ok {
 		return nil
 	}
-	return r.fields[name]
+	return field
+}
+
+func (r readImpl) getField(name string) (fieldImpl, bool) {
+	if field, ok := r.fields[name]; ok {
+		return field, true
+	}
+
+	if !isPath(name) {
+		return fieldImpl{}, false
+	}
+
+	segments, err := parsePath(name)
+	if err != nil {
+		return fieldImpl{}, false
+	}
+
+	field := fieldImpl{
+		value:   reflect.ValueOf(r.value),
+		convert: r.convert,
+	}
+
+	for _, segment := range segments {
+		var ok bool
+		if field, ok = field.child(segment); !ok {
+			return fieldImpl{}, false
+		}
+	}
+
+	return field, true
 }
 
 func (r readImpl) GetAllFields() []Field {
 	var fields []Field
 
-	for _, field := range r.fields {
-		fields = append(fields, field)
+	for _, name := range r.names {
+		fields = append(fields, r.fields[name])
 	}
 
 	return fields
@@ -349,7 +531,7 @@ func (r readImpl) ToSliceOfReaders() []Reader {
 	var readers []Reader
 
 	for i := 0; i < valueOf.Len(); i++ {
-		readers = append(readers, NewReader(valueOf.Index(i).Interface()))
+		readers = append(readers, newReader(valueOf.Index(i).Interface(), r.convert))
 	}
 
 	return readers
@@ -366,7 +548,7 @@ func (r readImpl) ToMapReaderOfReaders() map[interface{}]Reader {
 	readers := map[interface{}]Reader{}
 
 	for _, keyValue := range valueOf.MapKeys() {
-		readers[keyValue.Interface()] = NewReader(valueOf.MapIndex(keyValue).Interface())
+		readers[keyValue.Interface()] = newReader(valueOf.MapIndex(keyValue).Interface(), r.convert)
 	}
 
 	return readers
@@ -395,10 +577,95 @@ func (r readImpl) haveSameTypes(first reflect.Type, second reflect.Type) bool {
 	}
 }
 
+func (f fieldImpl) child(segment pathSegment) (fieldImpl, bool) {
+	value := f.value
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return fieldImpl{}, false
+		}
+		value = value.Elem()
+	}
+
+	if !segment.isIndex {
+		if value.Kind() != reflect.Struct {
+			return fieldImpl{}, false
+		}
+
+		field, ok := value.Type().FieldByName(segment.name)
+		if !ok {
+			return fieldImpl{}, false
+		}
+
+		fieldValue, err := value.FieldByIndexErr(field.Index)
+		if err != nil {
+			return fieldImpl{}, false
+		}
+
+		return fieldImpl{
+			field:   field,
+			value:   fieldValue,
+			convert: f.convert,
+		}, true
+	}
+
+	var element reflect.Value
+	var elementIndex []int
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, err := strconv.Atoi(segment.name)
+		if err != nil || index < 0 || index >= value.Len() {
+			return fieldImpl{}, false
+		}
+		element = value.Index(index)
+		elementIndex = []int{index}
+	case reflect.Map:
+		key, err := parsePathKey(segment.name, value.Type().Key())
+		if err != nil {
+			return fieldImpl{}, false
+		}
+		element = value.MapIndex(key)
+		if !element.IsValid() {
+			return fieldImpl{}, false
+		}
+	default:
+		return fieldImpl{}, false
+	}
+
+	return fieldImpl{
+		field: reflect.StructField{
+			Name:  segment.name,
+			Type:  element.Type(),
+			Index: elementIndex,
+		},
+		value:   element,
+		convert: f.convert,
+	}, true
+}
+
 func (f fieldImpl) Name() string {
 	return f.field.Name
 }
 
+func (f fieldImpl) Index() int {
+	if len(f.field.Index) == 0 {
+		return -1
+	}
+	return f.field.Index[len(f.field.Index)-1]
+}
+
+func (f fieldImpl) Offset() uintptr {
+	return f.field.Offset
+}
+
+func (f fieldImpl) Type() reflect.Type {
+	return f.field.Type
+}
+
+func (f fieldImpl) Tag() reflect.StructTag {
+	return f.field.Tag
+}
+
 func (f fieldImpl) PointerInt() *int {
 	if f.value.IsNil() {
 		return nil
@@ -408,6 +675,13 @@ func (f fieldImpl) PointerInt() *int {
 }
 
 func (f fieldImpl) Int() int {
+	if f.convert {
+		value, err := f.IntE()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return int(reflect.Indirect(f.value).Int())
 }
 
@@ -420,6 +694,13 @@ func (f fieldImpl) PointerInt8() *int8 {
 }
 
 func (f fieldImpl) Int8() int8 {
+	if f.convert {
+		value, err := f.Int8E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return int8(reflect.Indirect(f.value).Int())
 }
 
@@ -432,6 +713,13 @@ func (f fieldImpl) PointerInt16() *int16 {
 }
 
 func (f fieldImpl) Int16() int16 {
+	if f.convert {
+		value, err := f.Int16E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return int16(reflect.Indirect(f.value).Int())
 }
 
@@ -444,6 +732,13 @@ func (f fieldImpl) PointerInt32() *int32 {
 }
 
 func (f fieldImpl) Int32() int32 {
+	if f.convert {
+		value, err := f.Int32E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return int32(reflect.Indirect(f.value).Int())
 }
 
@@ -456,6 +751,13 @@ func (f fieldImpl) PointerInt64() *int64 {
 }
 
 func (f fieldImpl) Int64() int64 {
+	if f.convert {
+		value, err := f.Int64E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return reflect.Indirect(f.value).Int()
 }
 
@@ -468,6 +770,13 @@ func (f fieldImpl) PointerUint() *uint {
 }
 
 func (f fieldImpl) Uint() uint {
+	if f.convert {
+		value, err := f.UintE()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return uint(reflect.Indirect(f.value).Uint())
 }
 
@@ -480,6 +789,13 @@ func (f fieldImpl) PointerUint8() *uint8 {
 }
 
 func (f fieldImpl) Uint8() uint8 {
+	if f.convert {
+		value, err := f.Uint8E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return uint8(reflect.Indirect(f.value).Uint())
 }
 
@@ -492,6 +808,13 @@ func (f fieldImpl) PointerUint16() *uint16 {
 }
 
 func (f fieldImpl) Uint16() uint16 {
+	if f.convert {
+		value, err := f.Uint16E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return uint16(reflect.Indirect(f.value).Uint())
 }
 
@@ -504,6 +827,13 @@ func (f fieldImpl) PointerUint32() *uint32 {
 }
 
 func (f fieldImpl) Uint32() uint32 {
+	if f.convert {
+		value, err := f.Uint32E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return uint32(reflect.Indirect(f.value).Uint())
 }
 
@@ -516,6 +846,13 @@ func (f fieldImpl) PointerUint64() *uint64 {
 }
 
 func (f fieldImpl) Uint64() uint64 {
+	if f.convert {
+		value, err := f.Uint64E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return reflect.Indirect(f.value).Uint()
 }
 
@@ -528,6 +865,13 @@ func (f fieldImpl) PointerFloat32() *float32 {
 }
 
 func (f fieldImpl) Float32() float32 {
+	if f.convert {
+		value, err := f.Float32E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return float32(reflect.Indirect(f.value).Float())
 }
 
@@ -540,6 +884,13 @@ func (f fieldImpl) PointerFloat64() *float64 {
 }
 
 func (f fieldImpl) Float64() float64 {
+	if f.convert {
+		value, err := f.Float64E()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return reflect.Indirect(f.value).Float()
 }
 
@@ -552,6 +903,13 @@ func (f fieldImpl) PointerString() *string {
 }
 
 func (f fieldImpl) String() string {
+	if f.convert {
+		value, err := f.StringE()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return reflect.Indirect(f.value).String()
 }
 
@@ -564,6 +922,13 @@ func (f fieldImpl) PointerBool() *bool {
 }
 
 func (f fieldImpl) Bool() bool {
+	if f.convert {
+		value, err := f.BoolE()
+		if err != nil {
+			panic(err.Error())
+		}
+		return value
+	}
 	return reflect.Indirect(f.value).Bool()
 }
 
@@ -584,6 +949,252 @@ func (f fieldImpl) Time() time.Time {
 	return value
 }
 
+func (f fieldImpl) IntE() (int, error) {
+	value, err := f.signed(strconv.IntSize, "int")
+	return int(value), err
+}
+
+func (f fieldImpl) Int8E() (int8, error) {
+	value, err := f.signed(8, "int8")
+	return int8(value), err
+}
+
+func (f fieldImpl) Int16E() (int16, error) {
+	value, err := f.signed(16, "int16")
+	return int16(value), err
+}
+
+func (f fieldImpl) Int32E() (int32, error) {
+	value, err := f.signed(32, "int32")
+	return int32(value), err
+}
+
+func (f fieldImpl) Int64E() (int64, error) {
+	return f.signed(64, "int64")
+}
+
+func (f fieldImpl) UintE() (uint, error) {
+	value, err := f.unsigned(strconv.IntSize, "uint")
+	return uint(value), err
+}
+
+func (f fieldImpl) Uint8E() (uint8, error) {
+	value, err := f.unsigned(8, "uint8")
+	return uint8(value), err
+}
+
+func (f fieldImpl) Uint16E() (uint16, error) {
+	value, err := f.unsigned(16, "uint16")
+	return uint16(value), err
+}
+
+func (f fieldImpl) Uint32E() (uint32, error) {
+	value, err := f.unsigned(32, "uint32")
+	return uint32(value), err
+}
+
+func (f fieldImpl) Uint64E() (uint64, error) {
+	return f.unsigned(64, "uint64")
+}
+
+func (f fieldImpl) Float32E() (float32, error) {
+	value, err := f.float(32, "float32")
+	return float32(value), err
+}
+
+func (f fieldImpl) Float64E() (float64, error) {
+	return f.float(64, "float64")
+}
+
+func (f fieldImpl) StringE() (string, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return "", err
+	}
+
+	if f.convert {
+		result, err := convertToString(value)
+		return result, f.conversionError(err)
+	}
+
+	if value.Kind() != reflect.String {
+		return "", f.castError("string")
+	}
+
+	return value.String(), nil
+}
+
+func (f fieldImpl) BoolE() (bool, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return false, err
+	}
+
+	if f.convert {
+		result, err := convertToBool(value)
+		return result, f.conversionError(err)
+	}
+
+	if value.Kind() != reflect.Bool {
+		return false, f.castError("bool")
+	}
+
+	return value.Bool(), nil
+}
+
+func (f fieldImpl) TimeE() (time.Time, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !value.CanInterface() {
+		return time.Time{}, f.castError("time.Time")
+	}
+
+	result, ok := value.Interface().(time.Time)
+	if !ok {
+		return time.Time{}, f.castError("time.Time")
+	}
+
+	return result, nil
+}
+
+func (f fieldImpl) As(target interface{}) error {
+	targetOf := reflect.ValueOf(target)
+
+	if targetOf.Kind() != reflect.Ptr || targetOf.IsNil() {
+		return errors.New("As: expected a pointer as an argument")
+	}
+
+	value := f.value
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if !value.IsValid() || !value.CanInterface() {
+		return fmt.Errorf(`As: field "%s" can't be read`, f.field.Name)
+	}
+
+	err := assignValue(targetOf.Elem(), value.Interface())
+	if err == nil || value.Kind() != reflect.Ptr {
+		return f.asError(err)
+	}
+
+	if value.IsNil() {
+		return fmt.Errorf(`As: field "%s" is nil`, f.field.Name)
+	}
+
+	return f.asError(assignValue(targetOf.Elem(), value.Elem().Interface()))
+}
+
+func (f fieldImpl) asError(err error) error {
+	if err != nil {
+		return fmt.Errorf(`As: field "%s": %w`, f.field.Name, err)
+	}
+	return nil
+}
+
+func (f fieldImpl) indirect() (reflect.Value, error) {
+	value := f.value
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, fmt.Errorf(`field "%s" is nil`, f.field.Name)
+		}
+		value = value.Elem()
+	}
+
+	if !value.IsValid() {
+		return reflect.Value{}, fmt.Errorf(`field "%s" is not valid`, f.field.Name)
+	}
+
+	return value, nil
+}
+
+func (f fieldImpl) signed(bits int, typ string) (int64, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return 0, err
+	}
+
+	if f.convert {
+		result, err := convertToSigned(value, bits)
+		return result, f.conversionError(err)
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number := value.Int()
+		if bits < 64 && (number < -1<<(bits-1) || number > 1<<(bits-1)-1) {
+			return 0, f.overflowError(number, typ)
+		}
+		return number, nil
+	default:
+		return 0, f.castError(typ)
+	}
+}
+
+func (f fieldImpl) unsigned(bits int, typ string) (uint64, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return 0, err
+	}
+
+	if f.convert {
+		result, err := convertToUnsigned(value, bits)
+		return result, f.conversionError(err)
+	}
+
+	switch value.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number := value.Uint()
+		if bits < 64 && number > 1<<bits-1 {
+			return 0, f.overflowError(number, typ)
+		}
+		return number, nil
+	default:
+		return 0, f.castError(typ)
+	}
+}
+
+func (f fieldImpl) float(bits int, typ string) (float64, error) {
+	value, err := f.indirect()
+	if err != nil {
+		return 0, err
+	}
+
+	if f.convert {
+		result, err := convertToFloat(value, bits)
+		return result, f.conversionError(err)
+	}
+
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		number := value.Float()
+		if bits == 32 && reflect.Zero(reflect.TypeOf(float32(0))).OverflowFloat(number) {
+			return 0, f.overflowError(number, typ)
+		}
+		return number, nil
+	default:
+		return 0, f.castError(typ)
+	}
+}
+
+func (f fieldImpl) conversionError(err error) error {
+	if err != nil {
+		return fmt.Errorf(`field "%s": %w`, f.field.Name, err)
+	}
+	return nil
+}
+
+func (f fieldImpl) castError(typ string) error {
+	return fmt.Errorf(`field "%s" is not instance of %s`, f.field.Name, typ)
+}
+
+func (f fieldImpl) overflowError(value interface{}, typ string) error {
+	return fmt.Errorf(`field "%s" with value %v overflows %s`, f.field.Name, value, typ)
+}
+
 func (f fieldImpl) Interface() interface{} {
 	return f.value.Interface()
 }
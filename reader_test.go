@@ -39,6 +39,78 @@ func TestReaderImpl_GetField(t *testing.T) {
 	}
 }
 
+func TestReaderImpl_GetField_Path(t *testing.T) {
+	type Embedded struct {
+		Promoted string
+	}
+
+	type Nested struct {
+		Embedded
+		Text     string
+		Pointer  *testStructOne
+		Items    []testStructOne
+		Lookup   map[string]*testStructOne
+		Indexed  map[int]string
+		Anything interface{}
+	}
+
+	reader := NewReader(&Nested{
+		Embedded: Embedded{Promoted: "promoted"},
+		Text:     "text",
+		Pointer:  &testStructOne{String: "pointer"},
+		Items:    []testStructOne{{Integers: []int{1, 2, 3}}},
+		Lookup:   map[string]*testStructOne{"key": {Integer: 123}},
+		Indexed:  map[int]string{5: "five"},
+		Anything: map[string]interface{}{"inner": testStructOne{Bool: true}},
+	})
+
+	if value := reader.GetField("Pointer.String").String(); value != "pointer" {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Pointer.String" to be "pointer" got %#v`, value)
+	}
+	if value := reader.GetField("Items[0].Integers[2]").Int(); value != 3 {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Items[0].Integers[2]" to be 3 got %#v`, value)
+	}
+	if value := reader.GetField("Lookup[key].Integer").Int(); value != 123 {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Lookup[key].Integer" to be 123 got %#v`, value)
+	}
+	if value := reader.GetField("Indexed[5]").String(); value != "five" {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Indexed[5]" to be "five" got %#v`, value)
+	}
+	if value := reader.GetField("Anything[inner].Bool").Bool(); !value {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Anything[inner].Bool" to be true got %#v`, value)
+	}
+	if value := reader.GetField("Embedded.Promoted").String(); value != "promoted" {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field "Embedded.Promoted" to be "promoted" got %#v`, value)
+	}
+	if name := reader.GetField("Lookup[key]").Name(); name != "key" {
+		t.Errorf(`TestReaderImpl_GetField_Path - expected field's name to be "key" got %#v`, name)
+	}
+
+	for _, path := range []string{
+		"Text.Unknown",
+		"Items[1]",
+		"Items[-1]",
+		"Items[x]",
+		"Lookup[missing].Integer",
+		"Indexed[x]",
+		"Unknown.Text",
+		"Items[0",
+		"Pointer..String",
+		".Text",
+	} {
+		if reader.HasField(path) {
+			t.Errorf(`TestReaderImpl_GetField_Path - expected not to have field "%s"`, path)
+		}
+		if reader.GetField(path) != nil {
+			t.Errorf(`TestReaderImpl_GetField_Path - expected field "%s" to be nil`, path)
+		}
+	}
+
+	if NewReader(Nested{}).HasField("Pointer.String") {
+		t.Error(`TestReaderImpl_GetField_Path - expected not to have field "Pointer.String" for nil pointer`)
+	}
+}
+
 func TestReaderImpl_HasField(t *testing.T) {
 	reader := NewReader(testStructOne{
 		String: "some text",
@@ -58,6 +130,13 @@ func TestReaderImpl_GetAllFields(t *testing.T) {
 	if len(reader.GetAllFields()) != 13 {
 		t.Errorf(`TestReaderImpl_GetAllFields - expected to have 13 fields but got %d`, len(reader.GetAllFields()))
 	}
+
+	typeOf := reflect.TypeOf(testStructOne{})
+	for i, field := range reader.GetAllFields() {
+		if field.Name() != typeOf.Field(i).Name {
+			t.Errorf(`TestReaderImpl_GetAllFields - expected field #%d to be "%s" got "%s"`, i, typeOf.Field(i).Name, field.Name())
+		}
+	}
 }
 
 func TestReadImpl_HaveSameTypes(t *testing.T) {
@@ -255,6 +334,45 @@ func TestFieldImpl_Name(t *testing.T) {
 	}
 }
 
+func TestFieldImpl_Metadata(t *testing.T) {
+	type tagged struct {
+		First  int8
+		Second string `json:"second"`
+		Items  []int
+		Lookup map[string]int
+	}
+
+	reader := NewReader(tagged{
+		Items:  []int{1, 2, 3},
+		Lookup: map[string]int{"key": 1},
+	})
+
+	typeOf := reflect.TypeOf(tagged{})
+
+	field := reader.GetField("Second")
+	if field.Index() != 1 {
+		t.Errorf(`TestFieldImpl_Metadata - expected index to be 1 got %d`, field.Index())
+	}
+	if field.Offset() != typeOf.Field(1).Offset {
+		t.Errorf(`TestFieldImpl_Metadata - expected offset to be %d got %d`, typeOf.Field(1).Offset, field.Offset())
+	}
+	if field.Type() != reflect.TypeOf("") {
+		t.Errorf(`TestFieldImpl_Metadata - expected type to be string got %s`, field.Type())
+	}
+	if field.Tag().Get("json") != "second" {
+		t.Errorf(`TestFieldImpl_Metadata - expected json tag to be "second" got "%s"`, field.Tag().Get("json"))
+	}
+
+	element := reader.GetField("Items[2]")
+	if element.Index() != 2 || element.Type() != reflect.TypeOf(0) || element.Tag() != "" {
+		t.Errorf(`TestFieldImpl_Metadata - expected element with index 2 and type int got %d and %s`, element.Index(), element.Type())
+	}
+
+	if index := reader.GetField("Lookup[key]").Index(); index != -1 {
+		t.Errorf(`TestFieldImpl_Metadata - expected index of map's element to be -1 got %d`, index)
+	}
+}
+
 func TestFieldImpl_PointerInt(t *testing.T) {
 	expected := 123
 
@@ -819,3 +937,252 @@ func TestFieldImpl_Interface(t *testing.T) {
 		t.Errorf(`TestFieldImpl_Interface - expected field "String" to be equal %#v but got %#v`, expected, value)
 	}
 }
+
+func TestFieldImpl_E(t *testing.T) {
+	type numbers struct {
+		Int     int
+		Int8    int8
+		Int64   int64
+		Uint    uint
+		Uint16  uint16
+		Uint64  uint64
+		Float32 float32
+		Float64 float64
+		Text    string
+		Bool    bool
+		Time    *time.Time
+		Nil     *int
+		Any     interface{}
+	}
+
+	now := time.Now()
+	reader := NewReader(numbers{
+		Int:     -5,
+		Int8:    8,
+		Int64:   1 << 40,
+		Uint:    5,
+		Uint16:  300,
+		Uint64:  1 << 40,
+		Float32: 1.5,
+		Float64: 1e300,
+		Text:    "text",
+		Bool:    true,
+		Time:    &now,
+		Any:     int16(16),
+	})
+
+	valid := []struct {
+		name     string
+		read     func(Field) (interface{}, error)
+		expected interface{}
+	}{
+		{"Int", func(f Field) (interface{}, error) { return f.IntE() }, -5},
+		{"Int", func(f Field) (interface{}, error) { return f.Int8E() }, int8(-5)},
+		{"Int8", func(f Field) (interface{}, error) { return f.Int16E() }, int16(8)},
+		{"Int64", func(f Field) (interface{}, error) { return f.Int64E() }, int64(1 << 40)},
+		{"Any", func(f Field) (interface{}, error) { return f.Int32E() }, int32(16)},
+		{"Uint", func(f Field) (interface{}, error) { return f.UintE() }, uint(5)},
+		{"Uint16", func(f Field) (interface{}, error) { return f.Uint16E() }, uint16(300)},
+		{"Uint16", func(f Field) (interface{}, error) { return f.Uint32E() }, uint32(300)},
+		{"Uint64", func(f Field) (interface{}, error) { return f.Uint64E() }, uint64(1 << 40)},
+		{"Uint", func(f Field) (interface{}, error) { return f.Uint8E() }, uint8(5)},
+		{"Float32", func(f Field) (interface{}, error) { return f.Float32E() }, float32(1.5)},
+		{"Float64", func(f Field) (interface{}, error) { return f.Float64E() }, 1e300},
+		{"Text", func(f Field) (interface{}, error) { return f.StringE() }, "text"},
+		{"Bool", func(f Field) (interface{}, error) { return f.BoolE() }, true},
+		{"Time", func(f Field) (interface{}, error) { return f.TimeE() }, now},
+	}
+
+	for _, testCase := range valid {
+		value, err := testCase.read(reader.GetField(testCase.name))
+		if err != nil {
+			t.Errorf(`TestFieldImpl_E - expected not to have error for field "%s" got %#v`, testCase.name, err)
+		}
+		if value != testCase.expected {
+			t.Errorf(`TestFieldImpl_E - expected field "%s" to be %#v got %#v`, testCase.name, testCase.expected, value)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		read func(Field) (interface{}, error)
+	}{
+		{"Text", func(f Field) (interface{}, error) { return f.IntE() }},
+		{"Int64", func(f Field) (interface{}, error) { return f.Int8E() }},
+		{"Int64", func(f Field) (interface{}, error) { return f.Int16E() }},
+		{"Int64", func(f Field) (interface{}, error) { return f.Int32E() }},
+		{"Uint", func(f Field) (interface{}, error) { return f.Int64E() }},
+		{"Int", func(f Field) (interface{}, error) { return f.UintE() }},
+		{"Uint16", func(f Field) (interface{}, error) { return f.Uint8E() }},
+		{"Uint64", func(f Field) (interface{}, error) { return f.Uint16E() }},
+		{"Uint64", func(f Field) (interface{}, error) { return f.Uint32E() }},
+		{"Int", func(f Field) (interface{}, error) { return f.Uint64E() }},
+		{"Float64", func(f Field) (interface{}, error) { return f.Float32E() }},
+		{"Int", func(f Field) (interface{}, error) { return f.Float64E() }},
+		{"Int", func(f Field) (interface{}, error) { return f.StringE() }},
+		{"Int", func(f Field) (interface{}, error) { return f.BoolE() }},
+		{"Int", func(f Field) (interface{}, error) { return f.TimeE() }},
+		{"Nil", func(f Field) (interface{}, error) { return f.IntE() }},
+		{"Nil", func(f Field) (interface{}, error) { return f.StringE() }},
+		{"Nil", func(f Field) (interface{}, error) { return f.BoolE() }},
+		{"Nil", func(f Field) (interface{}, error) { return f.TimeE() }},
+	}
+
+	for _, testCase := range invalid {
+		if _, err := testCase.read(reader.GetField(testCase.name)); err == nil {
+			t.Errorf(`TestFieldImpl_E - expected error for field "%s"`, testCase.name)
+		}
+	}
+}
+
+func TestFieldImpl_As(t *testing.T) {
+	integer := 123
+	reader := NewReader(testStructOne{
+		Integer:        integer,
+		PointerInteger: &integer,
+		Integers:       []int{1, 2},
+	})
+
+	var number int64
+	if err := reader.GetField("Integer").As(&number); err != nil || number != 123 {
+		t.Errorf(`TestFieldImpl_As - expected 123 got %#v, %#v`, number, err)
+	}
+
+	var fromPointer int
+	if err := reader.GetField("PointerInteger").As(&fromPointer); err != nil || fromPointer != 123 {
+		t.Errorf(`TestFieldImpl_As - expected 123 got %#v, %#v`, fromPointer, err)
+	}
+
+	var pointer *int
+	if err := reader.GetField("PointerInteger").As(&pointer); err != nil || pointer != &integer {
+		t.Errorf(`TestFieldImpl_As - expected %#v got %#v, %#v`, &integer, pointer, err)
+	}
+
+	var allocated *float64
+	if err := reader.GetField("Integer").As(&allocated); err != nil || allocated == nil || *allocated != 123 {
+		t.Errorf(`TestFieldImpl_As - expected pointer to 123 got %#v, %#v`, allocated, err)
+	}
+
+	var slice []int
+	if err := reader.GetField("Integers").As(&slice); err != nil || !reflect.DeepEqual(slice, []int{1, 2}) {
+		t.Errorf(`TestFieldImpl_As - expected []int{1, 2} got %#v, %#v`, slice, err)
+	}
+
+	var small int8
+	var text string
+	errs := []error{
+		reader.GetField("Integer").As(small),
+		reader.GetField("Integer").As(nil),
+		reader.GetField("Integer").As(&text),
+		reader.GetField("PointerUinteger").As(&small),
+		NewReader(testStructOne{Integer: 1000}).GetField("Integer").As(&small),
+	}
+
+	for _, err := range errs {
+		if err == nil {
+			t.Error(`TestFieldImpl_As - expected error`)
+		}
+	}
+}
+
+func TestNewConvertingReader(t *testing.T) {
+	type mixed struct {
+		Text     string
+		Decimal  string
+		Precise  string
+		Negative int
+		Big      uint64
+		Float    float64
+		Fraction float64
+		Flag     string
+		Items    []interface{}
+	}
+
+	reader := NewConvertingReader(&mixed{
+		Text:     "42",
+		Decimal:  "12.5",
+		Precise:  "16777217",
+		Negative: -3,
+		Big:      1<<53 + 1,
+		Float:    300,
+		Fraction: 0.1,
+		Flag:     "true",
+		Items:    []interface{}{"7", 8.0},
+	})
+
+	valid := []struct {
+		name     string
+		read     func(Field) (interface{}, error)
+		expected interface{}
+	}{
+		{"Text", func(f Field) (interface{}, error) { return f.IntE() }, 42},
+		{"Text", func(f Field) (interface{}, error) { return f.Uint8E() }, uint8(42)},
+		{"Text", func(f Field) (interface{}, error) { return f.Float32E() }, float32(42)},
+		{"Decimal", func(f Field) (interface{}, error) { return f.Float64E() }, 12.5},
+		{"Precise", func(f Field) (interface{}, error) { return f.Float64E() }, float64(16777217)},
+		{"Negative", func(f Field) (interface{}, error) { return f.Int8E() }, int8(-3)},
+		{"Negative", func(f Field) (interface{}, error) { return f.Float64E() }, float64(-3)},
+		{"Negative", func(f Field) (interface{}, error) { return f.StringE() }, "-3"},
+		{"Big", func(f Field) (interface{}, error) { return f.Int64E() }, int64(1<<53 + 1)},
+		{"Big", func(f Field) (interface{}, error) { return f.StringE() }, "9007199254740993"},
+		{"Float", func(f Field) (interface{}, error) { return f.Uint16E() }, uint16(300)},
+		{"Float", func(f Field) (interface{}, error) { return f.Float32E() }, float32(300)},
+		{"Fraction", func(f Field) (interface{}, error) { return f.StringE() }, "0.1"},
+		{"Flag", func(f Field) (interface{}, error) { return f.BoolE() }, true},
+		{"Items[0]", func(f Field) (interface{}, error) { return f.Int16E() }, int16(7)},
+		{"Items[1]", func(f Field) (interface{}, error) { return f.Uint32E() }, uint32(8)},
+	}
+
+	for _, testCase := range valid {
+		value, err := testCase.read(reader.GetField(testCase.name))
+		if err != nil {
+			t.Errorf(`TestNewConvertingReader - expected not to have error for field "%s" got %#v`, testCase.name, err)
+		}
+		if value != testCase.expected {
+			t.Errorf(`TestNewConvertingReader - expected field "%s" to be %#v got %#v`, testCase.name, testCase.expected, value)
+		}
+	}
+
+	invalid := []struct {
+		name string
+		read func(Field) (interface{}, error)
+	}{
+		{"Decimal", func(f Field) (interface{}, error) { return f.IntE() }},
+		{"Precise", func(f Field) (interface{}, error) { return f.Float32E() }},
+		{"Negative", func(f Field) (interface{}, error) { return f.UintE() }},
+		{"Big", func(f Field) (interface{}, error) { return f.Float64E() }},
+		{"Big", func(f Field) (interface{}, error) { return f.Int32E() }},
+		{"Float", func(f Field) (interface{}, error) { return f.Int8E() }},
+		{"Fraction", func(f Field) (interface{}, error) { return f.Float32E() }},
+		{"Fraction", func(f Field) (interface{}, error) { return f.Int64E() }},
+		{"Flag", func(f Field) (interface{}, error) { return f.IntE() }},
+		{"Text", func(f Field) (interface{}, error) { return f.BoolE() }},
+		{"Items", func(f Field) (interface{}, error) { return f.StringE() }},
+	}
+
+	for _, testCase := range invalid {
+		if _, err := testCase.read(reader.GetField(testCase.name)); err == nil {
+			t.Errorf(`TestNewConvertingReader - expected error for field "%s"`, testCase.name)
+		}
+	}
+
+	if value := reader.GetField("Text").Int(); value != 42 {
+		t.Errorf(`TestNewConvertingReader - expected field "Text" to be 42 got %#v`, value)
+	}
+
+	if value := reader.ToSliceOfReaders(); value != nil {
+		t.Errorf(`TestNewConvertingReader - expected nil got %#v`, value)
+	}
+
+	readers := NewConvertingReader([]mixed{{Text: "5"}}).ToSliceOfReaders()
+	if value := readers[0].GetField("Text").Uint(); value != 5 {
+		t.Errorf(`TestNewConvertingReader - expected field "Text" of slice's element to be 5 got %#v`, value)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error(`TestNewConvertingReader - expected to panic for lossy conversion`)
+		}
+	}()
+	reader.GetField("Decimal").Int()
+}
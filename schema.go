@@ -0,0 +1,596 @@
+package dynamicstruct
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Schema is serializable definition of Builder's fields.
+	// It can be encoded as JSON with encoding/json package, or
+	// as YAML-like text with Text method, and loaded back into Builder.
+	Schema struct {
+		Fields []SchemaField `json:"fields"`
+	}
+
+	// SchemaField is serializable definition of single field.
+	// Type holds textual type's descriptor, like "int", "*string",
+	// "[]time.Time", "[3]float64" or "map[string][]byte".
+	// Descriptor "struct" stands for nested dynamic struct, which
+	// fields are listed in Fields. Without Fields, it stands for empty
+	// struct. It can be used as part of other descriptor, like
+	// "[]*struct", but only once per descriptor.
+	SchemaField struct {
+		Name      string        `json:"name"`
+		PkgPath   string        `json:"pkgPath,omitempty"`
+		Type      string        `json:"type"`
+		Tag       string        `json:"tag,omitempty"`
+		Anonymous bool          `json:"anonymous,omitempty"`
+		Fields    []SchemaField `json:"fields,omitempty"`
+	}
+
+	schemaTypeRegistry struct {
+		mutex   sync.RWMutex
+		byName  map[string]reflect.Type
+		byTypes map[reflect.Type]string
+	}
+
+	schemaTextLine struct {
+		number int
+		indent int
+		dash   bool
+		key    string
+		value  string
+	}
+
+	schemaTextParser struct {
+		lines    []schemaTextLine
+		position int
+	}
+)
+
+var schemaTypes = newSchemaTypeRegistry()
+
+func newSchemaTypeRegistry() *schemaTypeRegistry {
+	registry := &schemaTypeRegistry{
+		byName:  map[string]reflect.Type{},
+		byTypes: map[reflect.Type]string{},
+	}
+
+	for descriptor, typ := range map[string]reflect.Type{
+		"bool":            reflect.TypeOf(false),
+		"int":             reflect.TypeOf(0),
+		"int8":            reflect.TypeOf(int8(0)),
+		"int16":           reflect.TypeOf(int16(0)),
+		"int32":           reflect.TypeOf(int32(0)),
+		"int64":           reflect.TypeOf(int64(0)),
+		"uint":            reflect.TypeOf(uint(0)),
+		"uint8":           reflect.TypeOf(uint8(0)),
+		"uint16":          reflect.TypeOf(uint16(0)),
+		"uint32":          reflect.TypeOf(uint32(0)),
+		"uint64":          reflect.TypeOf(uint64(0)),
+		"uintptr":         reflect.TypeOf(uintptr(0)),
+		"float32":         reflect.TypeOf(float32(0)),
+		"float64":         reflect.TypeOf(float64(0)),
+		"complex64":       reflect.TypeOf(complex64(0)),
+		"complex128":      reflect.TypeOf(complex128(0)),
+		"string":          reflect.TypeOf(""),
+		"time.Time":       reflect.TypeOf(time.Time{}),
+		"time.Duration":   reflect.TypeOf(time.Duration(0)),
+		"json.RawMessage": reflect.TypeOf(json.RawMessage{}),
+		"error":           reflect.TypeOf((*error)(nil)).Elem(),
+		"interface{}":     reflect.TypeOf((*interface{})(nil)).Elem(),
+	} {
+		registry.register(descriptor, typ)
+	}
+
+	registry.byName["byte"] = reflect.TypeOf(byte(0))
+	registry.byName["rune"] = reflect.TypeOf(rune(0))
+	registry.byName["any"] = reflect.TypeOf((*interface{})(nil)).Elem()
+
+	return registry
+}
+
+// RegisterSchemaType registers named type under desired descriptor,
+// so it can be used in Schema. Primitive types, time.Time, time.Duration,
+// json.RawMessage, error and interface{} are registered by default.
+//
+// dynamicstruct.RegisterSchemaType("uuid.UUID", reflect.TypeOf(uuid.UUID{}))
+//
+func RegisterSchemaType(descriptor string, typ reflect.Type) {
+	schemaTypes.register(descriptor, typ)
+}
+
+// NewSchema returns serializable definition of all fields from Builder.
+// It returns an error if some field's type can't be described.
+//
+// schema, err := dynamicstruct.NewSchema(builder)
+//
+func NewSchema(builder Builder) (Schema, error) {
+	impl, ok := unwrapBuilder(builder)
+	if !ok {
+		return Schema{}, errors.New("NewSchema: unsupported implementation of Builder")
+	}
+
+	var fields []SchemaField
+
+	for _, field := range impl.fields {
+		typ := field.reflectType()
+		if typ == nil {
+			return Schema{}, fmt.Errorf(`NewSchema: field "%s" has nil type`, field.name)
+		}
+
+		schemaField, err := newSchemaField(field.name, field.pkg, typ, impl.fieldTag(field), field.anonymous)
+		if err != nil {
+			return Schema{}, fmt.Errorf("NewSchema: %w", err)
+		}
+
+		fields = append(fields, schemaField)
+	}
+
+	return Schema{
+		Fields: fields,
+	}, nil
+}
+
+// ParseSchemaText parses Schema from YAML-like text,
+// as it is returned by Schema's Text method.
+//
+// schema, err := dynamicstruct.ParseSchemaText(text)
+//
+func ParseSchemaText(text string) (Schema, error) {
+	parser, err := newSchemaTextParser(text)
+	if err != nil {
+		return Schema{}, fmt.Errorf("ParseSchemaText: %w", err)
+	}
+
+	fields, err := parser.parse()
+	if err != nil {
+		return Schema{}, fmt.Errorf("ParseSchemaText: %w", err)
+	}
+
+	return Schema{
+		Fields: fields,
+	}, nil
+}
+
+// Builder returns new instance of Builder interface with all fields
+// defined in Schema. It returns an error if some type's descriptor
+// can't be resolved.
+//
+// builder, err := schema.Builder()
+//
+func (s Schema) Builder() (Builder, error) {
+	builder, err := schemaBuilder(s.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("Schema.Builder: %w", err)
+	}
+
+	return builder, nil
+}
+
+// Text returns Schema encoded as YAML-like text.
+//
+// text := schema.Text()
+//
+func (s Schema) Text() string {
+	var text strings.Builder
+
+	if len(s.Fields) == 0 {
+		text.WriteString("fields: []\n")
+		return text.String()
+	}
+
+	text.WriteString("fields:\n")
+	writeSchemaFields(&text, s.Fields, "  ")
+
+	return text.String()
+}
+
+func newSchemaField(name string, pkg string, typ reflect.Type, tag string, anonymous bool) (SchemaField, error) {
+	descriptor, nested, err := schemaTypes.describe(typ)
+	if err != nil {
+		return SchemaField{}, fmt.Errorf(`field "%s": %w`, name, err)
+	}
+
+	field := SchemaField{
+		Name:      name,
+		PkgPath:   pkg,
+		Type:      descriptor,
+		Tag:       tag,
+		Anonymous: anonymous,
+	}
+
+	if nested != nil {
+		field.Fields = []SchemaField{}
+
+		for i := 0; i < nested.NumField(); i++ {
+			nestedField := nested.Field(i)
+
+			schemaField, err := newSchemaField(nestedField.Name, nestedField.PkgPath, nestedField.Type, string(nestedField.Tag), nestedField.Anonymous)
+			if err != nil {
+				return SchemaField{}, fmt.Errorf(`field "%s": %w`, name, err)
+			}
+
+			field.Fields = append(field.Fields, schemaField)
+		}
+	}
+
+	return field, nil
+}
+
+func schemaBuilder(fields []SchemaField) (*builderImpl, error) {
+	builder := NewStruct().(*builderImpl)
+
+	for _, field := range fields {
+		var nested reflect.Type
+
+		if field.Fields != nil {
+			nestedBuilder, err := schemaBuilder(field.Fields)
+			if err != nil {
+				return nil, fmt.Errorf(`field "%s": %w`, field.Name, err)
+			}
+
+			dynamicStruct, err := nestedBuilder.BuildE()
+			if err != nil {
+				return nil, fmt.Errorf(`field "%s": %w`, field.Name, err)
+			}
+
+			nested = dynamicStruct.(*dynamicStructImpl).definition
+		}
+
+		typ, err := schemaTypes.resolve(field.Type, nested)
+		if err != nil {
+			return nil, fmt.Errorf(`field "%s": %w`, field.Name, err)
+		}
+
+		builder.addField(field.Name, field.PkgPath, declaredType{typ: typ}, field.Tag, field.Anonymous)
+	}
+
+	return builder, nil
+}
+
+func (r *schemaTypeRegistry) register(descriptor string, typ reflect.Type) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.byName[descriptor] = typ
+	r.byTypes[typ] = descriptor
+}
+
+func (r *schemaTypeRegistry) describe(typ reflect.Type) (string, reflect.Type, error) {
+	r.mutex.RLock()
+	descriptor, ok := r.byTypes[typ]
+	r.mutex.RUnlock()
+
+	if ok {
+		return descriptor, nil, nil
+	}
+
+	if typ.Name() != "" {
+		return "", nil, fmt.Errorf("type %s is not registered", typ)
+	}
+
+	var prefix string
+	var nested reflect.Type
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		prefix = "*"
+	case reflect.Slice:
+		prefix = "[]"
+	case reflect.Array:
+		prefix = "[" + strconv.Itoa(typ.Len()) + "]"
+	case reflect.Map:
+		key, keyNested, err := r.describe(typ.Key())
+		if err != nil {
+			return "", nil, err
+		}
+		prefix, nested = "map["+key+"]", keyNested
+	case reflect.Struct:
+		return "struct", typ, nil
+	default:
+		return "", nil, fmt.Errorf("type %s is not supported", typ)
+	}
+
+	elem, elemNested, err := r.describe(typ.Elem())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if nested != nil && elemNested != nil {
+		return "", nil, fmt.Errorf("type %s contains more than one struct", typ)
+	}
+	if nested == nil {
+		nested = elemNested
+	}
+
+	return prefix + elem, nested, nil
+}
+
+func (r *schemaTypeRegistry) resolve(descriptor string, nested reflect.Type) (reflect.Type, error) {
+	typ, rest, used, err := r.parse(descriptor, nested)
+	if err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		return nil, fmt.Errorf(`unexpected "%s" in type "%s"`, rest, descriptor)
+	}
+
+	if nested != nil && !used {
+		return nil, fmt.Errorf(`type "%s" doesn't contain struct, but fields are defined`, descriptor)
+	}
+
+	return typ, nil
+}
+
+func (r *schemaTypeRegistry) parse(descriptor string, nested reflect.Type) (reflect.Type, string, bool, error) {
+	switch {
+	case strings.HasPrefix(descriptor, "*"):
+		elem, rest, used, err := r.parse(descriptor[1:], nested)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return reflect.PtrTo(elem), rest, used, nil
+	case strings.HasPrefix(descriptor, "[]"):
+		elem, rest, used, err := r.parse(descriptor[2:], nested)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return reflect.SliceOf(elem), rest, used, nil
+	case strings.HasPrefix(descriptor, "["):
+		end := strings.Index(descriptor, "]")
+		if end < 0 {
+			return nil, "", false, fmt.Errorf(`missing "]" in type "%s"`, descriptor)
+		}
+		length, err := strconv.Atoi(descriptor[1:end])
+		if err != nil || length < 0 {
+			return nil, "", false, fmt.Errorf(`invalid array length in type "%s"`, descriptor)
+		}
+		elem, rest, used, err := r.parse(descriptor[end+1:], nested)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return reflect.ArrayOf(length, elem), rest, used, nil
+	case strings.HasPrefix(descriptor, "map["):
+		key, rest, keyUsed, err := r.parse(descriptor[4:], nested)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if !strings.HasPrefix(rest, "]") {
+			return nil, "", false, fmt.Errorf(`missing "]" in type "%s"`, descriptor)
+		}
+		if keyUsed {
+			nested = nil
+		}
+		elem, rest, elemUsed, err := r.parse(rest[1:], nested)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if !key.Comparable() {
+			return nil, "", false, fmt.Errorf(`invalid map key in type "%s"`, descriptor)
+		}
+		return reflect.MapOf(key, elem), rest, keyUsed || elemUsed, nil
+	}
+
+	end := strings.Index(descriptor, "]")
+	if end < 0 {
+		end = len(descriptor)
+	}
+	name, rest := descriptor[:end], descriptor[end:]
+
+	if name == "struct" {
+		if nested == nil {
+			return reflect.TypeOf(struct{}{}), rest, false, nil
+		}
+		return nested, rest, true, nil
+	}
+
+	r.mutex.RLock()
+	typ, ok := r.byName[name]
+	r.mutex.RUnlock()
+
+	if !ok {
+		return nil, "", false, fmt.Errorf(`type "%s" is not registered`, name)
+	}
+
+	return typ, rest, false, nil
+}
+
+func writeSchemaFields(text *strings.Builder, fields []SchemaField, indent string) {
+	for _, field := range fields {
+		text.WriteString(indent + "- name: " + field.Name + "\n")
+		if field.PkgPath != "" {
+			text.WriteString(indent + "  pkgPath: " + quoteSchemaText(field.PkgPath) + "\n")
+		}
+		text.WriteString(indent + "  type: " + quoteSchemaText(field.Type) + "\n")
+		if field.Tag != "" {
+			text.WriteString(indent + "  tag: " + quoteSchemaText(field.Tag) + "\n")
+		}
+		if field.Anonymous {
+			text.WriteString(indent + "  anonymous: true\n")
+		}
+		if field.Fields != nil {
+			if len(field.Fields) == 0 {
+				text.WriteString(indent + "  fields: []\n")
+				continue
+			}
+			text.WriteString(indent + "  fields:\n")
+			writeSchemaFields(text, field.Fields, indent+"    ")
+		}
+	}
+}
+
+func quoteSchemaText(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func unquoteSchemaText(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'"), nil
+	case strings.HasPrefix(value, `"`):
+		return strconv.Unquote(value)
+	default:
+		return value, nil
+	}
+}
+
+func newSchemaTextParser(text string) (*schemaTextParser, error) {
+	parser := &schemaTextParser{}
+
+	for i, raw := range strings.Split(text, "\n") {
+		content := strings.TrimRight(raw, " \r")
+		trimmed := strings.TrimLeft(content, " ")
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+
+		line := schemaTextLine{
+			number: i + 1,
+			indent: len(content) - len(trimmed),
+		}
+
+		if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+			line.dash = true
+			trimmed = strings.TrimLeft(strings.TrimPrefix(trimmed, "-"), " ")
+		}
+
+		separator := strings.Index(trimmed, ":")
+		if separator < 0 || (separator+1 < len(trimmed) && trimmed[separator+1] != ' ') {
+			return nil, fmt.Errorf(`line %d: expected "key: value"`, i+1)
+		}
+
+		line.key = trimmed[:separator]
+		line.value = strings.TrimSpace(trimmed[separator+1:])
+		parser.lines = append(parser.lines, line)
+	}
+
+	return parser, nil
+}
+
+func (p *schemaTextParser) parse() ([]SchemaField, error) {
+	if len(p.lines) == 0 {
+		return nil, errors.New(`expected "fields" key`)
+	}
+
+	root := p.lines[0]
+	if root.dash || root.key != "fields" || root.indent != 0 {
+		return nil, fmt.Errorf(`line %d: expected "fields" key`, root.number)
+	}
+	p.position++
+
+	fields, err := p.list(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.position < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected content", p.lines[p.position].number)
+	}
+
+	return fields, nil
+}
+
+func (p *schemaTextParser) list(parent schemaTextLine) ([]SchemaField, error) {
+	if parent.value == "[]" {
+		return []SchemaField{}, nil
+	}
+	if parent.value != "" {
+		return nil, fmt.Errorf(`line %d: expected list of fields`, parent.number)
+	}
+
+	fields := []SchemaField{}
+
+	if p.position >= len(p.lines) || !p.lines[p.position].dash || p.lines[p.position].indent < parent.indent {
+		return fields, nil
+	}
+
+	indent := p.lines[p.position].indent
+
+	for p.position < len(p.lines) {
+		line := p.lines[p.position]
+		if line.indent < indent || (line.indent == indent && !line.dash) {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", line.number)
+		}
+
+		field, err := p.field(line)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func (p *schemaTextParser) field(first schemaTextLine) (SchemaField, error) {
+	var field SchemaField
+
+	keyIndent := first.indent + 2
+	line := first
+
+	for {
+		p.position++
+
+		if err := p.set(&field, line); err != nil {
+			return SchemaField{}, err
+		}
+
+		if p.position >= len(p.lines) {
+			break
+		}
+
+		line = p.lines[p.position]
+		if line.dash || line.indent != keyIndent {
+			break
+		}
+	}
+
+	return field, nil
+}
+
+func (p *schemaTextParser) set(field *SchemaField, line schemaTextLine) error {
+	var err error
+
+	switch line.key {
+	case "name":
+		field.Name, err = unquoteSchemaText(line.value)
+	case "pkgPath":
+		field.PkgPath, err = unquoteSchemaText(line.value)
+	case "type":
+		field.Type, err = unquoteSchemaText(line.value)
+	case "tag":
+		field.Tag, err = unquoteSchemaText(line.value)
+	case "anonymous":
+		field.Anonymous, err = strconv.ParseBool(line.value)
+	case "fields":
+		field.Fields, err = p.list(line)
+	default:
+		err = fmt.Errorf(`unknown key "%s"`, line.key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("line %d: %w", line.number, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package dynamicstruct
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewSchema(t *testing.T) {
+	builder := NewStruct().
+		AddField("Integer", 0, `json:"int"`).
+		AddField("Text", "", `json:"it's"`).
+		AddField("Times", []*time.Time{}, "").
+		AddField("Lookup", map[string][2]float64{}, "").
+		AddField("Nested", []*struct {
+			Flag bool `json:"flag"`
+			Raw  json.RawMessage
+		}{}, "").
+		AddFieldType("Error", reflect.TypeOf((*error)(nil)).Elem(), "")
+
+	schema, err := NewSchema(builder)
+	if err != nil {
+		t.Fatalf(`TestNewSchema - expected not to have error got %#v`, err)
+	}
+
+	expected := Schema{
+		Fields: []SchemaField{
+			{Name: "Integer", Type: "int", Tag: `json:"int"`},
+			{Name: "Text", Type: "string", Tag: `json:"it's"`},
+			{Name: "Times", Type: "[]*time.Time"},
+			{Name: "Lookup", Type: "map[string][2]float64"},
+			{Name: "Nested", Type: "[]*struct", Fields: []SchemaField{
+				{Name: "Flag", Type: "bool", Tag: `json:"flag"`},
+				{Name: "Raw", Type: "json.RawMessage"},
+			}},
+			{Name: "Error", Type: "error"},
+		},
+	}
+
+	if !reflect.DeepEqual(schema, expected) {
+		t.Errorf(`TestNewSchema - expected schema to be %#v got %#v`, expected, schema)
+	}
+
+	definition := reflect.TypeOf(builder.Build().New())
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf(`TestNewSchema - expected not to have error got %#v`, err)
+	}
+
+	var decoded Schema
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf(`TestNewSchema - expected not to have error got %#v`, err)
+	}
+
+	loaded, err := decoded.Builder()
+	if err != nil {
+		t.Fatalf(`TestNewSchema - expected not to have error got %#v`, err)
+	}
+
+	if result := reflect.TypeOf(loaded.Build().New()); result != definition {
+		t.Errorf(`TestNewSchema - expected loaded struct from JSON to be %s got %s`, definition, result)
+	}
+
+	parsed, err := ParseSchemaText(schema.Text())
+	if err != nil {
+		t.Fatalf(`TestNewSchema - expected not to have error got %#v`, err)
+	}
+
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf(`TestNewSchema - expected parsed schema to be %#v got %#v`, expected, parsed)
+	}
+}
+
+func TestNewSchema_Errors(t *testing.T) {
+	type Unregistered struct{}
+
+	builders := []Builder{
+		NewStruct().AddField("Named", Unregistered{}, ""),
+		NewStruct().AddField("Func", func() {}, ""),
+		NewStruct().AddField("Nil", nil, ""),
+		NewStruct().AddField("Twice", map[struct{ A int }]struct{ B int }{}, ""),
+	}
+
+	for _, builder := range builders {
+		if _, err := NewSchema(builder); err == nil {
+			t.Errorf(`TestNewSchema_Errors - expected error for builder %#v`, builder)
+		}
+	}
+}
+
+func TestRegisterSchemaType(t *testing.T) {
+	type Registered struct {
+		Value int
+	}
+
+	RegisterSchemaType("test.Registered", reflect.TypeOf(Registered{}))
+
+	schema, err := NewSchema(NewStruct().AddField("Field", map[Registered]*Registered{}, ""))
+	if err != nil {
+		t.Fatalf(`TestRegisterSchemaType - expected not to have error got %#v`, err)
+	}
+
+	if schema.Fields[0].Type != "map[test.Registered]*test.Registered" {
+		t.Errorf(`TestRegisterSchemaType - expected type to be "map[test.Registered]*test.Registered" got "%s"`, schema.Fields[0].Type)
+	}
+
+	builder, err := schema.Builder()
+	if err != nil {
+		t.Fatalf(`TestRegisterSchemaType - expected not to have error got %#v`, err)
+	}
+
+	expected := reflect.TypeOf(map[Registered]*Registered{})
+	if typ := builder.(*builderImpl).fields[0].reflectType(); typ != expected {
+		t.Errorf(`TestRegisterSchemaType - expected type to be %s got %s`, expected, typ)
+	}
+}
+
+func TestSchema_Text(t *testing.T) {
+	schema := Schema{
+		Fields: []SchemaField{
+			{Name: "Text", Type: "*string", Tag: `json:"it's"`},
+			{Name: "Embedded", Type: "struct", Anonymous: true, Fields: []SchemaField{
+				{Name: "Value", Type: "[]int"},
+			}},
+			{Name: "Empty", Type: "struct", Fields: []SchemaField{}},
+		},
+	}
+
+	expected := `fields:
+  - name: Text
+    type: '*string'
+    tag: 'json:"it''s"'
+  - name: Embedded
+    type: 'struct'
+    anonymous: true
+    fields:
+      - name: Value
+        type: '[]int'
+  - name: Empty
+    type: 'struct'
+    fields: []
+`
+
+	if text := schema.Text(); text != expected {
+		t.Errorf(`TestSchema_Text - expected text to be %s got %s`, expected, text)
+	}
+
+	if text := (Schema{}).Text(); text != "fields: []\n" {
+		t.Errorf(`TestSchema_Text - expected text to be "fields: []" got %s`, text)
+	}
+}
+
+func TestParseSchemaText(t *testing.T) {
+	text := `
+# handwritten schema
+fields:
+- name: Integer
+  type: int
+  tag: "json:\"int\""
+- name: Nested
+  type: "map[string]struct"
+  fields:
+  - name: Value
+    type: '[3]byte'
+  tag: 'json:"nested"'
+`
+
+	schema, err := ParseSchemaText(text)
+	if err != nil {
+		t.Fatalf(`TestParseSchemaText - expected not to have error got %#v`, err)
+	}
+
+	expected := Schema{
+		Fields: []SchemaField{
+			{Name: "Integer", Type: "int", Tag: `json:"int"`},
+			{Name: "Nested", Type: "map[string]struct", Tag: `json:"nested"`, Fields: []SchemaField{
+				{Name: "Value", Type: "[3]byte"},
+			}},
+		},
+	}
+
+	if !reflect.DeepEqual(schema, expected) {
+		t.Errorf(`TestParseSchemaText - expected schema to be %#v got %#v`, expected, schema)
+	}
+
+	invalid := []string{
+		``,
+		`values:`,
+		"fields:\n  - name: Field\n    unknown: value",
+		"fields:\n  - name: Field\n      type: int",
+		"fields:\n  - name: 'Field",
+		"fields:\n  - name Field",
+	}
+
+	for _, text := range invalid {
+		if _, err := ParseSchemaText(text); err == nil {
+			t.Errorf(`TestParseSchemaText - expected error for text %s`, text)
+		}
+	}
+}
+
+func TestSchema_Builder_Errors(t *testing.T) {
+	schemas := []Schema{
+		{Fields: []SchemaField{{Name: "Field", Type: "unknown"}}},
+		{Fields: []SchemaField{{Name: "Field", Type: "[]int]"}}},
+		{Fields: []SchemaField{{Name: "Field", Type: "[x]int"}}},
+		{Fields: []SchemaField{{Name: "Field", Type: "map[[]int]int"}}},
+		{Fields: []SchemaField{{Name: "Field", Type: "int", Fields: []SchemaField{}}}},
+	}
+
+	for _, schema := range schemas {
+		if _, err := schema.Builder(); err == nil {
+			t.Errorf(`TestSchema_Builder_Errors - expected error for schema %#v`, schema)
+		}
+	}
+}
+
+func TestSchema_EmptyStruct(t *testing.T) {
+	builder := NewStruct().
+		AddField("Empty", struct{}{}, `json:"empty"`).
+		AddField("Empties", []*struct{}{}, "")
+
+	schema, err := NewSchema(builder)
+	if err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	var decoded Schema
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	loaded, err := decoded.Builder()
+	if err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	definition := reflect.TypeOf(builder.Build().New())
+	if result := reflect.TypeOf(loaded.Build().New()); result != definition {
+		t.Errorf(`TestSchema_EmptyStruct - expected loaded struct from JSON to be %s got %s`, definition, result)
+	}
+
+	parsed, err := ParseSchemaText(schema.Text())
+	if err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	loaded, err = parsed.Builder()
+	if err != nil {
+		t.Fatalf(`TestSchema_EmptyStruct - expected not to have error got %#v`, err)
+	}
+
+	if result := reflect.TypeOf(loaded.Build().New()); result != definition {
+		t.Errorf(`TestSchema_EmptyStruct - expected loaded struct from text to be %s got %s`, definition, result)
+	}
+}
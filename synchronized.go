@@ -0,0 +1,308 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"sync"
+)
+
+type (
+	// syncBuilder is Builder guarded by a mutex, so all its methods,
+	// and methods of its fields' definitions, can be called concurrently.
+	syncBuilder struct {
+		mutex   *sync.RWMutex
+		builder *builderImpl
+	}
+
+	// syncFieldConfig is field's definition which belongs to syncBuilder
+	// and is guarded by the same mutex.
+	syncFieldConfig struct {
+		owner *syncBuilder
+		field *fieldConfigImpl
+	}
+)
+
+// Synchronized returns copy of Builder which is safe for concurrent use.
+// All its methods, and methods of fields' definitions returned by
+// its GetField and Fields, and nested Builders returned by theirs
+// Builder method, are guarded by the same mutex. Mapper
+// provided to MapTags must not call returned Builder.
+// Immutable Builder is already safe for concurrent use, so it is
+// returned as it is.
+//
+// builder := dynamicstruct.Synchronized(dynamicstruct.NewStruct())
+//
+func Synchronized(builder Builder) Builder {
+	switch builder := builder.(type) {
+	case *syncBuilder, *immutableBuilder:
+		return builder
+	}
+
+	return &syncBuilder{
+		mutex:   &sync.RWMutex{},
+		builder: builder.Clone().(*builderImpl),
+	}
+}
+
+func (b *syncBuilder) change(change func(builder *builderImpl)) Builder {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	change(b.builder)
+	return b
+}
+
+func (b *syncBuilder) AddField(name string, typ interface{}, tag string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.AddField(name, typ, tag)
+	})
+}
+
+func (b *syncBuilder) AddInterfaceField(name string, typ interface{}, tag string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.AddInterfaceField(name, typ, tag)
+	})
+}
+
+func (b *syncBuilder) AddStructField(name string, define func(builder Builder), tag string) Builder {
+	nested := newNestedStruct(reflect.Struct, nil, define)
+	return b.change(func(builder *builderImpl) {
+		builder.AddField(name, nested, tag)
+	})
+}
+
+func (b *syncBuilder) AddSliceOfStructsField(name string, define func(builder Builder), tag string) Builder {
+	nested := newNestedStruct(reflect.Slice, nil, define)
+	return b.change(func(builder *builderImpl) {
+		builder.AddField(name, nested, tag)
+	})
+}
+
+func (b *syncBuilder) AddMapOfStructsField(name string, key interface{}, define func(builder Builder), tag string) Builder {
+	nested := newNestedMap(key, define)
+	return b.change(func(builder *builderImpl) {
+		builder.AddField(name, nested, tag)
+	})
+}
+
+func (b *syncBuilder) AddFieldType(name string, typ reflect.Type, tag string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.AddFieldType(name, typ, tag)
+	})
+}
+
+func (b *syncBuilder) AddFields(fields ...FieldDefinition) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.AddFields(fields...)
+	})
+}
+
+func (b *syncBuilder) RemoveField(name string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.RemoveField(name)
+	})
+}
+
+func (b *syncBuilder) InsertFieldBefore(mark string, name string, typ interface{}, tag string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.InsertFieldBefore(mark, name, typ, tag)
+	})
+}
+
+func (b *syncBuilder) InsertFieldAfter(mark string, name string, typ interface{}, tag string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.InsertFieldAfter(mark, name, typ, tag)
+	})
+}
+
+func (b *syncBuilder) MoveField(name string, index int) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.MoveField(name, index)
+	})
+}
+
+func (b *syncBuilder) RenameField(name string, newName string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.RenameField(name, newName)
+	})
+}
+
+func (b *syncBuilder) ReorderFields(names ...string) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.ReorderFields(names...)
+	})
+}
+
+func (b *syncBuilder) HasField(name string) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.builder.HasField(name)
+}
+
+func (b *syncBuilder) GetField(name string) FieldConfig {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if field := b.builder.getFieldByPath(name); field != nil {
+		return &syncFieldConfig{
+			owner: b,
+			field: field,
+		}
+	}
+	return nil
+}
+
+func (b *syncBuilder) Fields() []FieldConfig {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	fields := make([]FieldConfig, 0, len(b.builder.fields))
+	for _, field := range b.builder.fields {
+		fields = append(fields, &syncFieldConfig{
+			owner: b,
+			field: field,
+		})
+	}
+	return fields
+}
+
+func (b *syncBuilder) MapTags(mapper func(name string, tags Tags) Tags) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.MapTags(mapper)
+	})
+}
+
+func (b *syncBuilder) GenerateTags(rules ...TagRule) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.GenerateTags(rules...)
+	})
+}
+
+func (b *syncBuilder) Build() DynamicStruct {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.builder.Build()
+}
+
+func (b *syncBuilder) UseCache(cache *TypeCache) Builder {
+	return b.change(func(builder *builderImpl) {
+		builder.UseCache(cache)
+	})
+}
+
+func (b *syncBuilder) BuildE() (DynamicStruct, error) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.builder.BuildE()
+}
+
+func (b *syncBuilder) Clone() Builder {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.builder.clone()
+}
+
+func (b *syncBuilder) Immutable() Builder {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.builder.Immutable()
+}
+
+func (f *syncFieldConfig) Name() string {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.Name()
+}
+
+func (f *syncFieldConfig) Type() reflect.Type {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.Type()
+}
+
+func (f *syncFieldConfig) Tag() string {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.Tag()
+}
+
+func (f *syncFieldConfig) Anonymous() bool {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.Anonymous()
+}
+
+func (f *syncFieldConfig) PkgPath() string {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.PkgPath()
+}
+
+func (f *syncFieldConfig) Builder() Builder {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	if nested, ok := f.field.typ.(*nestedStruct); ok {
+		return &syncBuilder{
+			mutex:   f.owner.mutex,
+			builder: nested.builder,
+		}
+	}
+	return nil
+}
+
+func (f *syncFieldConfig) SetType(typ interface{}) FieldConfig {
+	f.owner.mutex.Lock()
+	defer f.owner.mutex.Unlock()
+
+	f.field.SetType(typ)
+	return f
+}
+
+func (f *syncFieldConfig) SetTypeOf(typ reflect.Type) FieldConfig {
+	f.owner.mutex.Lock()
+	defer f.owner.mutex.Unlock()
+
+	f.field.SetTypeOf(typ)
+	return f
+}
+
+func (f *syncFieldConfig) SetTag(tag string) FieldConfig {
+	f.owner.mutex.Lock()
+	defer f.owner.mutex.Unlock()
+
+	f.field.SetTag(tag)
+	return f
+}
+
+func (f *syncFieldConfig) GetTag(key string) string {
+	f.owner.mutex.RLock()
+	defer f.owner.mutex.RUnlock()
+
+	return f.field.GetTag(key)
+}
+
+func (f *syncFieldConfig) SetTagKey(key string, value string) FieldConfig {
+	f.owner.mutex.Lock()
+	defer f.owner.mutex.Unlock()
+
+	f.field.SetTagKey(key, value)
+	return f
+}
+
+func (f *syncFieldConfig) RemoveTagKey(key string) FieldConfig {
+	f.owner.mutex.Lock()
+	defer f.owner.mutex.Unlock()
+
+	f.field.RemoveTagKey(key)
+	return f
+}
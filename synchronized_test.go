@@ -0,0 +1,110 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSynchronized(t *testing.T) {
+	base := NewStruct().AddField("ID", 0, `json:"id"`)
+
+	builder := Synchronized(base)
+	if _, ok := builder.(*syncBuilder); !ok {
+		t.Fatalf(`TestSynchronized - expected synchronized builder got %#v`, builder)
+	}
+
+	if Synchronized(builder) != builder {
+		t.Error(`TestSynchronized - expected synchronized builder to return itself`)
+	}
+
+	immutable := base.Immutable()
+	if Synchronized(immutable) != immutable {
+		t.Error(`TestSynchronized - expected immutable builder to return itself`)
+	}
+
+	builder.AddField("Name", "", "").
+		InsertFieldBefore("ID", "Tenant", "", "").
+		InsertFieldAfter("ID", "Version", 0, "").
+		MoveField("Version", 100).
+		RenameField("Name", "Title").
+		ReorderFields("ID").
+		RemoveField("Tenant").
+		MapTags(func(name string, tags Tags) Tags { return tags }).
+		GenerateTags(TagRule{Key: "db", Strategy: SnakeCase}).
+		UseCache(NewTypeCache(0))
+
+	if base.HasField("Title") {
+		t.Error(`TestSynchronized - expected original builder to be unchanged`)
+	}
+
+	expected := []string{"ID", "Title", "Version"}
+	if names := testBuilderFieldNames(builder.Clone()); !reflect.DeepEqual(names, expected) {
+		t.Errorf(`TestSynchronized - expected fields to be %#v got %#v`, expected, names)
+	}
+
+	field := builder.GetField("ID")
+	field.SetType(int64(0)).SetTag(`json:"id"`).SetTagKey("xml", "id").RemoveTagKey("json")
+	if field.Name() != "ID" || field.Type() != reflect.TypeOf(int64(0)) || field.Tag() != `xml:"id"` ||
+		field.GetTag("xml") != "id" || field.Anonymous() || field.PkgPath() != "" {
+		t.Errorf(`TestSynchronized - unexpected field %#v`, field)
+	}
+
+	if builder.GetField("Undefined") != nil || len(builder.Fields()) != 3 || !builder.HasField("Title") {
+		t.Error(`TestSynchronized - expected to read fields of builder`)
+	}
+
+	definition := builder.Build().Type()
+	if field, _ := definition.FieldByName("ID"); field.Tag != `xml:"id" db:"id"` {
+		t.Errorf(`TestSynchronized - expected generated tag got %#v`, field.Tag)
+	}
+
+	if _, ok := builder.Immutable().(*immutableBuilder); !ok {
+		t.Error(`TestSynchronized - expected immutable copy`)
+	}
+
+	if _, err := NewSchema(builder); err != nil {
+		t.Errorf(`TestSynchronized - expected not to have error got %#v`, err)
+	}
+}
+
+func TestSynchronized_Concurrent(t *testing.T) {
+	builder := Synchronized(NewStruct().AddField("ID", 0, ""))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			name := fmt.Sprintf("Field%d", i)
+			builder.AddField(name, i, "")
+			builder.GetField(name).SetTagKey("json", name)
+			builder.GetField("ID").SetTagKey("json", "id")
+
+			for _, field := range builder.Fields() {
+				_ = field.Tag()
+			}
+
+			if _, err := builder.BuildE(); err != nil {
+				t.Errorf(`TestSynchronized_Concurrent - expected not to have error got %#v`, err)
+			}
+			_ = builder.HasField(name)
+			_ = builder.Clone()
+		}(i)
+	}
+	wg.Wait()
+
+	definition := builder.Build().Type()
+	if definition.NumField() != 51 {
+		t.Errorf(`TestSynchronized_Concurrent - expected 51 fields got %d`, definition.NumField())
+	}
+
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("Field%d", i)
+		if field, ok := definition.FieldByName(name); !ok || field.Tag.Get("json") != name {
+			t.Errorf(`TestSynchronized_Concurrent - expected field "%s" with tag got %#v`, name, field)
+		}
+	}
+}
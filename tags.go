@@ -0,0 +1,164 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Tag is single key and value pair of struct field's tag.
+	Tag struct {
+		Key   string
+		Value string
+	}
+
+	// Tags holds all key and value pairs of struct field's tag,
+	// in the same order as they are written in tag.
+	Tags []Tag
+)
+
+// ParseTags parses classical golang field tag into Tags.
+// It returns an error if tag doesn't follow conventional
+// reflect.StructTag syntax.
+//
+// tags, err := dynamicstruct.ParseTags(`json:"name" validate:"required"`)
+//
+func ParseTags(tag string) (Tags, error) {
+	tags := Tags{}
+
+	rest := strings.TrimLeft(tag, " ")
+	for rest != "" {
+		end := 0
+		for end < len(rest) && rest[end] > ' ' && rest[end] != ':' && rest[end] != '"' && rest[end] != 0x7f {
+			end++
+		}
+
+		if end == 0 || end+1 >= len(rest) || rest[end] != ':' || rest[end+1] != '"' {
+			return nil, fmt.Errorf("ParseTags: invalid syntax in tag %q", tag)
+		}
+
+		key := rest[:end]
+		rest = rest[end+1:]
+
+		end = 1
+		for end < len(rest) && rest[end] != '"' {
+			if rest[end] == '\\' {
+				end++
+			}
+			end++
+		}
+
+		if end >= len(rest) {
+			return nil, fmt.Errorf("ParseTags: unterminated value of key %q in tag %q", key, tag)
+		}
+
+		value, err := strconv.Unquote(rest[:end+1])
+		if err != nil {
+			return nil, fmt.Errorf("ParseTags: invalid value of key %q in tag %q", key, tag)
+		}
+
+		tags = append(tags, Tag{
+			Key:   key,
+			Value: value,
+		})
+		rest = strings.TrimLeft(rest[end+1:], " ")
+	}
+
+	return tags, nil
+}
+
+// Get returns value of first pair with a given key and
+// reports whether such pair exists.
+//
+// value, ok := tags.Get("json")
+//
+func (t Tags) Get(key string) (string, bool) {
+	for _, tag := range t {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Set changes value of pair with a given key, or adds new pair
+// at the end. Other pairs with the same key are removed.
+// Keys which can't be used in tag are ignored.
+//
+// tags.Set("json", "name,omitempty")
+//
+func (t *Tags) Set(key string, value string) {
+	if !isTagKey(key) {
+		return
+	}
+
+	for i, tag := range *t {
+		if tag.Key == key {
+			(*t)[i].Value = value
+			*t = append((*t)[:i+1], (*t)[i+1:].without(key)...)
+			return
+		}
+	}
+
+	*t = append(*t, Tag{
+		Key:   key,
+		Value: value,
+	})
+}
+
+// Remove removes all pairs with a given key.
+//
+// tags.Remove("json")
+//
+func (t *Tags) Remove(key string) {
+	*t = t.without(key)
+}
+
+// String returns Tags as classical golang field tag.
+// Pairs with keys which can't be used in tag are left out.
+//
+// field.SetTag(tags.String())
+//
+func (t Tags) String() string {
+	var result strings.Builder
+
+	for _, tag := range t {
+		if !isTagKey(tag.Key) {
+			continue
+		}
+
+		if result.Len() > 0 {
+			result.WriteByte(' ')
+		}
+		result.WriteString(tag.Key)
+		result.WriteByte(':')
+		result.WriteString(strconv.Quote(tag.Value))
+	}
+
+	return result.String()
+}
+
+func (t Tags) without(key string) Tags {
+	result := make(Tags, 0, len(t))
+	for _, tag := range t {
+		if tag.Key != key {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+func isTagKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		if key[i] <= ' ' || key[i] == ':' || key[i] == '"' || key[i] == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
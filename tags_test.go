@@ -0,0 +1,115 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := ParseTags(` json:"name,omitempty"  validate:"required" quoted:"a \"b\"\tc" empty:""`)
+	if err != nil {
+		t.Fatalf(`TestParseTags - expected not to have error got %#v`, err)
+	}
+
+	expected := Tags{
+		{Key: "json", Value: "name,omitempty"},
+		{Key: "validate", Value: "required"},
+		{Key: "quoted", Value: "a \"b\"\tc"},
+		{Key: "empty", Value: ""},
+	}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf(`TestParseTags - expected tags to be %#v got %#v`, expected, tags)
+	}
+
+	tags, err = ParseTags("")
+	if err != nil || len(tags) != 0 {
+		t.Errorf(`TestParseTags - expected empty tags got %#v, %#v`, tags, err)
+	}
+
+	for _, tag := range []string{`json`, `json:name`, `json:"name`, `:"name"`, `json :"name"`, `json:"\q"`} {
+		if _, err := ParseTags(tag); err == nil {
+			t.Errorf(`TestParseTags - expected error for %#v`, tag)
+		}
+	}
+}
+
+func TestTags(t *testing.T) {
+	tags, _ := ParseTags(`json:"name" db:"first" validate:"required" db:"second"`)
+
+	if value, ok := tags.Get("db"); !ok || value != "first" {
+		t.Errorf(`TestTags - expected value "first" got %#v`, value)
+	}
+	if _, ok := tags.Get("yaml"); ok {
+		t.Error(`TestTags - expected not to have key "yaml"`)
+	}
+
+	tags.Set("db", "column")
+	tags.Set("yaml", "name")
+	tags.Set("invalid key", "value")
+	tags.Remove("validate")
+
+	expected := `json:"name" db:"column" yaml:"name"`
+	if tags.String() != expected {
+		t.Errorf(`TestTags - expected tag to be %#v got %#v`, expected, tags.String())
+	}
+
+	tags = Tags{{Key: "json", Value: "a\"b"}, {Key: "in:valid", Value: "value"}}
+	if value := reflect.StructTag(tags.String()).Get("json"); value != "a\"b" {
+		t.Errorf(`TestTags - expected value "a\"b" got %#v`, value)
+	}
+	if strings.Contains(tags.String(), "in:valid") {
+		t.Errorf(`TestTags - expected invalid key to be left out got %#v`, tags.String())
+	}
+}
+
+func TestFieldConfigImpl_TagKeys(t *testing.T) {
+	field := &fieldConfigImpl{
+		tag: `json:"field" validate:"required"`,
+	}
+
+	if value := field.GetTag("json"); value != "field" {
+		t.Errorf(`TestFieldConfigImpl_TagKeys - expected value "field" got %#v`, value)
+	}
+
+	field.SetTagKey("json", "other,omitempty").SetTagKey("db", "other").RemoveTagKey("validate")
+
+	expected := `json:"other,omitempty" db:"other"`
+	if field.tag != expected {
+		t.Errorf(`TestFieldConfigImpl_TagKeys - expected tag to be %#v got %#v`, expected, field.tag)
+	}
+
+	field.SetTagKey("", "value").SetTagKey("in valid", "value")
+	if field.tag != expected {
+		t.Errorf(`TestFieldConfigImpl_TagKeys - expected tag to be %#v got %#v`, expected, field.tag)
+	}
+
+	field.SetTag(`invalid`).SetTagKey("json", "value").RemoveTagKey("invalid")
+	if field.tag != `invalid` {
+		t.Errorf(`TestFieldConfigImpl_TagKeys - expected invalid tag to be unchanged got %#v`, field.tag)
+	}
+}
+
+func TestBuilderImpl_MapTags(t *testing.T) {
+	builder := NewStruct().
+		AddField("FirstName", "", `json:"FirstName" validate:"required"`).
+		AddField("Age", 0, "").
+		AddField("Invalid", 0, `invalid`).
+		MapTags(func(name string, tags Tags) Tags {
+			tags.Set("json", strings.ToLower(name))
+			tags.Set("db", strings.ToLower(name))
+			return tags
+		})
+
+	expected := map[string]string{
+		"FirstName": `json:"firstname" validate:"required" db:"firstname"`,
+		"Age":       `json:"age" db:"age"`,
+		"Invalid":   `invalid`,
+	}
+
+	for name, tag := range expected {
+		if field := builder.GetField(name).(*fieldConfigImpl); field.tag != tag {
+			t.Errorf(`TestBuilderImpl_MapTags - expected tag to be %#v got %#v`, tag, field.tag)
+		}
+	}
+}
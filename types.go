@@ -0,0 +1,119 @@
+package dynamicstruct
+
+import (
+	"reflect"
+)
+
+// FieldDefinition holds field's definition with explicitly
+// declared type, which doesn't need a sample value.
+type FieldDefinition struct {
+	// Name is field's name.
+	Name string
+	// Type is field's type.
+	Type reflect.Type
+	// Tag is classical golang field tag.
+	Tag string
+}
+
+// FieldOf returns definition of field whose type is provided
+// as type parameter. It works with interface types too.
+//
+// builder.AddFields(dynamicstruct.FieldOf[*int]("SomeIntPointer", `json:"int"`))
+//
+func FieldOf[T any](name string, tag string) FieldDefinition {
+	return FieldDefinition{
+		Name: name,
+		Type: TypeOf[T](),
+		Tag:  tag,
+	}
+}
+
+// TypeOf returns reflect.Type of type parameter.
+// Unlike reflect.TypeOf, it returns interface type itself
+// for interface types, instead of type of theirs values.
+//
+// typ := dynamicstruct.TypeOf[io.Reader]()
+//
+func TypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// PtrOf returns pointer type to given type. Type is provided
+// as an instance of some golang type, or as reflect.Type.
+// For nil argument, it returns nil.
+//
+// builder.AddFieldType("SomeIntPointer", dynamicstruct.PtrOf(0), "")
+//
+func PtrOf(typ interface{}) reflect.Type {
+	elem := typeOfDescriptor(typ)
+	if elem == nil {
+		return nil
+	}
+	return reflect.PtrTo(elem)
+}
+
+// SliceOf returns slice type with given element's type. Type is
+// provided as an instance of some golang type, or as reflect.Type.
+// For nil argument, it returns nil.
+//
+// builder.AddFieldType("SomeStrings", dynamicstruct.SliceOf(""), "")
+//
+func SliceOf(typ interface{}) reflect.Type {
+	elem := typeOfDescriptor(typ)
+	if elem == nil {
+		return nil
+	}
+	return reflect.SliceOf(elem)
+}
+
+// MapOf returns map type with given key's and element's types. Types
+// are provided as instances of some golang types, or as reflect.Type.
+// For nil arguments, or key's type which is not comparable, it returns nil.
+//
+// builder.AddFieldType("SomeMap", dynamicstruct.MapOf("", dynamicstruct.PtrOf(0)), "")
+//
+func MapOf(key interface{}, typ interface{}) reflect.Type {
+	keyType, elem := typeOfDescriptor(key), typeOfDescriptor(typ)
+	if keyType == nil || elem == nil || !keyType.Comparable() {
+		return nil
+	}
+	return reflect.MapOf(keyType, elem)
+}
+
+func (b *builderImpl) AddFieldType(name string, typ reflect.Type, tag string) Builder {
+	return b.AddField(name, declaredType{typ: typ}, tag)
+}
+
+func (f *fieldConfigImpl) SetTypeOf(typ reflect.Type) FieldConfig {
+	return f.SetType(declaredType{typ: typ})
+}
+
+func (b *builderImpl) AddFields(fields ...FieldDefinition) Builder {
+	for _, field := range fields {
+		b.AddFieldType(field.Name, field.Type, field.Tag)
+	}
+	return b
+}
+
+func typeOfDescriptor(typ interface{}) reflect.Type {
+	if typ, ok := typ.(reflect.Type); ok {
+		return typ
+	}
+	return reflect.TypeOf(typ)
+}
+
+func interfaceType(typ interface{}) interface{} {
+	if typ, ok := typ.(reflect.Type); ok {
+		if typ == nil || typ.Kind() != reflect.Interface {
+			return invalidType("type is not an interface")
+		}
+		return declaredType{typ: typ}
+	}
+
+	typeOf := reflect.TypeOf(typ)
+	if typeOf == nil || typeOf.Kind() != reflect.Ptr || typeOf.Elem().Kind() != reflect.Interface {
+		return invalidType("type is not a pointer to interface")
+	}
+
+	return declaredType{typ: typeOf.Elem()}
+}
@@ -0,0 +1,118 @@
+package dynamicstruct
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTypeOf(t *testing.T) {
+	tests := map[reflect.Type]reflect.Type{
+		TypeOf[int]():              reflect.TypeOf(0),
+		TypeOf[*time.Time]():       reflect.TypeOf(&time.Time{}),
+		TypeOf[io.Reader]():        reflect.TypeOf((*io.Reader)(nil)).Elem(),
+		TypeOf[error]():            reflect.TypeOf((*error)(nil)).Elem(),
+		TypeOf[interface{}]():      reflect.TypeOf((*interface{})(nil)).Elem(),
+		TypeOf[map[string][]int](): reflect.TypeOf(map[string][]int{}),
+	}
+
+	for typ, expected := range tests {
+		if typ != expected {
+			t.Errorf(`TestTypeOf - expected type %s got %s`, expected, typ)
+		}
+	}
+}
+
+func TestTypeDescriptors(t *testing.T) {
+	reader := TypeOf[io.Reader]()
+
+	tests := []struct {
+		typ      reflect.Type
+		expected reflect.Type
+	}{
+		{typ: PtrOf(0), expected: reflect.TypeOf(new(int))},
+		{typ: PtrOf(reader), expected: reflect.TypeOf(new(io.Reader))},
+		{typ: PtrOf(nil), expected: nil},
+		{typ: SliceOf(""), expected: reflect.TypeOf([]string{})},
+		{typ: SliceOf(PtrOf(time.Time{})), expected: reflect.TypeOf([]*time.Time{})},
+		{typ: SliceOf(nil), expected: nil},
+		{typ: MapOf("", reader), expected: reflect.TypeOf(map[string]io.Reader{})},
+		{typ: MapOf(0, SliceOf(0.0)), expected: reflect.TypeOf(map[int][]float64{})},
+		{typ: MapOf([]int{}, 0), expected: nil},
+		{typ: MapOf(nil, 0), expected: nil},
+		{typ: MapOf("", nil), expected: nil},
+	}
+
+	for _, test := range tests {
+		if test.typ != test.expected {
+			t.Errorf(`TestTypeDescriptors - expected type %v got %v`, test.expected, test.typ)
+		}
+	}
+}
+
+func TestFieldConfigImpl_SetTypeOf(t *testing.T) {
+	builder := NewStruct().AddField("Reader", "", "")
+	builder.GetField("Reader").SetTypeOf(TypeOf[io.Reader]())
+
+	synchronized := Synchronized(NewStruct().AddField("Handler", 0, ""))
+	synchronized.GetField("Handler").SetTypeOf(TypeOf[func() error]())
+
+	tests := map[Builder]reflect.Type{
+		builder:      TypeOf[io.Reader](),
+		synchronized: TypeOf[func() error](),
+	}
+
+	for builder, expected := range tests {
+		if field := builder.Build().Type().Field(0); field.Type != expected {
+			t.Errorf(`TestFieldConfigImpl_SetTypeOf - expected field of type %s got %s`, expected, field.Type)
+		}
+	}
+
+	builder.GetField("Reader").SetTypeOf(nil)
+	if _, err := builder.BuildE(); err == nil {
+		t.Error(`TestFieldConfigImpl_SetTypeOf - expected error for nil type`)
+	}
+}
+
+func TestBuilderImpl_AddFields(t *testing.T) {
+	builder := NewStruct().
+		AddFields(
+			FieldOf[int]("Integer", `json:"int"`),
+			FieldOf[*int]("PointerInteger", ""),
+			FieldOf[io.Reader]("Reader", ""),
+		).
+		AddFieldType("Strings", SliceOf(""), `json:"strings"`).
+		AddFieldType("Handlers", MapOf("", TypeOf[func() error]()), "")
+
+	expected := map[string]reflect.Type{
+		"Integer":        reflect.TypeOf(0),
+		"PointerInteger": reflect.TypeOf(new(int)),
+		"Reader":         TypeOf[io.Reader](),
+		"Strings":        reflect.TypeOf([]string{}),
+		"Handlers":       reflect.TypeOf(map[string]func() error{}),
+	}
+
+	definition := builder.Build().Type()
+	for name, typ := range expected {
+		if field, _ := definition.FieldByName(name); field.Type != typ {
+			t.Errorf(`TestBuilderImpl_AddFields - expected field "%s" to be %s got %v`, name, typ, field.Type)
+		}
+	}
+
+	if field, _ := definition.FieldByName("Integer"); field.Tag != `json:"int"` {
+		t.Errorf(`TestBuilderImpl_AddFields - expected tag %#v got %#v`, `json:"int"`, field.Tag)
+	}
+
+	for _, builder := range []Builder{NewStruct().Immutable(), Synchronized(NewStruct())} {
+		builder = builder.AddFields(FieldOf[int]("Integer", "")).AddFieldType("Reader", TypeOf[io.Reader](), "")
+		if definition := builder.Build().Type(); definition.NumField() != 2 || definition.Field(1).Type != TypeOf[io.Reader]() {
+			t.Errorf(`TestBuilderImpl_AddFields - expected fields to be added got %s`, definition)
+		}
+	}
+
+	_, err := NewStruct().AddFieldType("Invalid", MapOf([]int{}, 0), "").BuildE()
+	if buildError, ok := err.(*BuildError); !ok || buildError.Fields[0].Reason != "type is nil" {
+		t.Errorf(`TestBuilderImpl_AddFields - expected error for nil type got %#v`, err)
+	}
+}
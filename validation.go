@@ -0,0 +1,131 @@
+package dynamicstruct
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+type (
+	// invalidType is field's type which can't be used in dynamic
+	// struct, with explanation why it is rejected.
+	invalidType string
+
+	// FieldError describes single invalid field's definition
+	// found while building dynamic struct.
+	FieldError struct {
+		// Index is field's position in Builder.
+		Index int
+		// Name is field's name, as it was provided.
+		Name string
+		// Reason explains why field's definition is invalid.
+		Reason string
+	}
+
+	// BuildError is returned by BuildE when dynamic struct can't be built.
+	// It contains all invalid fields' definitions, or a general reason
+	// when definition is rejected as a whole.
+	BuildError struct {
+		// Fields holds all invalid fields' definitions.
+		Fields []FieldError
+		// Reason holds general reason why definition is rejected.
+		Reason string
+	}
+)
+
+// Error returns description of invalid field's definition.
+func (e FieldError) Error() string {
+	return fmt.Sprintf(`field #%d "%s": %s`, e.Index, e.Name, e.Reason)
+}
+
+// Error returns description of all invalid fields' definitions.
+func (e *BuildError) Error() string {
+	var reasons []string
+
+	for _, field := range e.Fields {
+		reasons = append(reasons, field.Error())
+	}
+	if e.Reason != "" {
+		reasons = append(reasons, e.Reason)
+	}
+
+	return "dynamicstruct: invalid struct definition: " + strings.Join(reasons, "; ")
+}
+
+// validate checks all fields' definitions and returns theirs
+// resolved types, so types of nested structs are built only once.
+func (b *builderImpl) validate() ([]reflect.Type, error) {
+	var fieldErrors []FieldError
+
+	names := make(map[string]int, len(b.fields))
+	types := make([]reflect.Type, 0, len(b.fields))
+
+	for i, field := range b.fields {
+		typ, reasons := field.validate()
+		types = append(types, typ)
+
+		for _, reason := range reasons {
+			fieldErrors = append(fieldErrors, FieldError{
+				Index:  i,
+				Name:   field.name,
+				Reason: reason,
+			})
+		}
+
+		if field.name == "" {
+			continue
+		}
+
+		if first, ok := names[field.name]; ok {
+			fieldErrors = append(fieldErrors, FieldError{
+				Index:  i,
+				Name:   field.name,
+				Reason: fmt.Sprintf("duplicate of field #%d", first),
+			})
+			continue
+		}
+		names[field.name] = i
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, &BuildError{
+			Fields: fieldErrors,
+		}
+	}
+
+	return types, nil
+}
+
+func (f *fieldConfigImpl) validate() (reflect.Type, []string) {
+	var reasons []string
+
+	switch {
+	case f.name == "":
+		reasons = append(reasons, "name is empty")
+	case !token.IsIdentifier(f.name):
+		reasons = append(reasons, "name is not a valid identifier")
+	case !token.IsExported(f.name) && f.pkg == "":
+		reasons = append(reasons, "name is not exported and package path is empty")
+	case f.anonymous && f.pkg != "":
+		reasons = append(reasons, "embedded field of unexported type is not supported")
+	}
+
+	var typ reflect.Type
+
+	switch fieldType := f.typ.(type) {
+	case *nestedStruct:
+		var nestedReasons []string
+		typ, nestedReasons = fieldType.build()
+		reasons = append(reasons, nestedReasons...)
+	case invalidType:
+		reasons = append(reasons, string(fieldType))
+	default:
+		typ = f.reflectType()
+		if typ == nil {
+			reasons = append(reasons, "type is nil")
+		}
+	}
+
+	return typ, reasons
+}
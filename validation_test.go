@@ -0,0 +1,76 @@
+package dynamicstruct
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuilderImpl_BuildE(t *testing.T) {
+	dynamicStruct, err := NewStruct().
+		AddField("Field", 0, `key:"value"`).
+		BuildE()
+
+	if err != nil {
+		t.Errorf(`TestBuilderImpl_BuildE - expected not to have error got %#v`, err)
+	}
+
+	if dynamicStruct == nil {
+		t.Error(`TestBuilderImpl_BuildE - expected instance of DynamicStruct got nil`)
+	}
+
+	dynamicStruct, err = NewStruct().
+		AddField("", 0, "").
+		AddField("lower", 0, "").
+		AddField("Not Valid", 0, "").
+		AddField("Nil", nil, "").
+		AddField("Field", 0, "").
+		AddField("Field", "", "").
+		BuildE()
+
+	if dynamicStruct != nil {
+		t.Errorf(`TestBuilderImpl_BuildE - expected nil got %#v`, dynamicStruct)
+	}
+
+	var buildError *BuildError
+	if !errors.As(err, &buildError) {
+		t.Fatalf(`TestBuilderImpl_BuildE - expected instance of *BuildError got %#v`, err)
+	}
+
+	expected := []FieldError{
+		{Index: 0, Name: "", Reason: "name is empty"},
+		{Index: 1, Name: "lower", Reason: "name is not exported and package path is empty"},
+		{Index: 2, Name: "Not Valid", Reason: "name is not a valid identifier"},
+		{Index: 3, Name: "Nil", Reason: "type is nil"},
+		{Index: 5, Name: "Field", Reason: "duplicate of field #4"},
+	}
+
+	if !reflect.DeepEqual(buildError.Fields, expected) {
+		t.Errorf(`TestBuilderImpl_BuildE - expected field errors to be %#v got %#v`, expected, buildError.Fields)
+	}
+}
+
+func TestBuilderImpl_Build(t *testing.T) {
+	defer func() {
+		r := recover()
+		if _, ok := r.(*BuildError); !ok {
+			t.Errorf(`TestBuilderImpl_Build - expected to panic with *BuildError got %#v`, r)
+		}
+	}()
+
+	NewStruct().AddField("lower", 0, "").Build()
+}
+
+func TestBuildError_Error(t *testing.T) {
+	err := &BuildError{
+		Fields: []FieldError{
+			{Index: 0, Name: "lower", Reason: "name is not exported"},
+			{Index: 2, Name: "Nil", Reason: "type is nil"},
+		},
+	}
+
+	expected := `dynamicstruct: invalid struct definition: field #0 "lower": name is not exported; field #2 "Nil": type is nil`
+	if err.Error() != expected {
+		t.Errorf(`TestBuildError_Error - expected error to be "%s" got "%s"`, expected, err.Error())
+	}
+}
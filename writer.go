@@ -0,0 +1,354 @@
+package dynamicstruct
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+type (
+	// Writer is helper interface which provides possibility to
+	// set values of struct instance's fields by theirs names.
+	Writer interface {
+		// HasField checks if struct instance has a field with a given name.
+		// Name can be a path to nested field, as it is described for SetField.
+		//
+		// if writer.HasField("SomeFloatField") { ...
+		//
+		HasField(name string) bool
+		// SetField sets value of struct instance's field.
+		// Name can be a path to nested field, with dots between fields' names
+		// and brackets for elements of slices, arrays and maps.
+		// Nil pointers and maps are allocated on the way. Value is converted
+		// to field's type when it is possible without loss, or dereferenced
+		// when field expects a pointer.
+		// It returns an error if field doesn't exist or can't hold the value.
+		//
+		// err := writer.SetField("SomeStruct.Items[0]", 123)
+		//
+		SetField(name string, value interface{}) error
+		// SetInt sets value of int type.
+		//
+		// err := writer.SetInt("SomeField", 123)
+		//
+		SetInt(name string, value int) error
+		// SetInt8 sets value of int8 type.
+		//
+		// err := writer.SetInt8("SomeField", 123)
+		//
+		SetInt8(name string, value int8) error
+		// SetInt16 sets value of int16 type.
+		//
+		// err := writer.SetInt16("SomeField", 123)
+		//
+		SetInt16(name string, value int16) error
+		// SetInt32 sets value of int32 type.
+		//
+		// err := writer.SetInt32("SomeField", 123)
+		//
+		SetInt32(name string, value int32) error
+		// SetInt64 sets value of int64 type.
+		//
+		// err := writer.SetInt64("SomeField", 123)
+		//
+		SetInt64(name string, value int64) error
+		// SetUint sets value of uint type.
+		//
+		// err := writer.SetUint("SomeField", 123)
+		//
+		SetUint(name string, value uint) error
+		// SetUint8 sets value of uint8 type.
+		//
+		// err := writer.SetUint8("SomeField", 123)
+		//
+		SetUint8(name string, value uint8) error
+		// SetUint16 sets value of uint16 type.
+		//
+		// err := writer.SetUint16("SomeField", 123)
+		//
+		SetUint16(name string, value uint16) error
+		// SetUint32 sets value of uint32 type.
+		//
+		// err := writer.SetUint32("SomeField", 123)
+		//
+		SetUint32(name string, value uint32) error
+		// SetUint64 sets value of uint64 type.
+		//
+		// err := writer.SetUint64("SomeField", 123)
+		//
+		SetUint64(name string, value uint64) error
+		// SetFloat32 sets value of float32 type.
+		//
+		// err := writer.SetFloat32("SomeField", 123.45)
+		//
+		SetFloat32(name string, value float32) error
+		// SetFloat64 sets value of float64 type.
+		//
+		// err := writer.SetFloat64("SomeField", 123.45)
+		//
+		SetFloat64(name string, value float64) error
+		// SetString sets value of string type.
+		//
+		// err := writer.SetString("SomeField", "text")
+		//
+		SetString(name string, value string) error
+		// SetBool sets value of bool type.
+		//
+		// err := writer.SetBool("SomeField", true)
+		//
+		SetBool(name string, value bool) error
+		// SetTime sets value of time.Time{} type.
+		//
+		// err := writer.SetTime("SomeField", time.Now())
+		//
+		SetTime(name string, value time.Time) error
+		// GetValue returns original value used in writer.
+		//
+		// instance := writer.GetValue()
+		//
+		GetValue() interface{}
+	}
+
+	writeImpl struct {
+		value interface{}
+	}
+)
+
+// NewWriter provides instance of Writer interface to give possibility
+// to set all fields' values of struct instance.
+// It returns an error if argument is not a pointer to a struct.
+//
+// writer, err := dynamicstruct.NewWriter(dStruct.New())
+//
+func NewWriter(value interface{}) (Writer, error) {
+	valueOf := reflect.ValueOf(value)
+
+	if valueOf.Kind() != reflect.Ptr || valueOf.IsNil() {
+		return nil, errors.New("NewWriter: expected a pointer as an argument")
+	}
+
+	if valueOf.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("NewWriter: expected a pointer to struct as an argument")
+	}
+
+	return writeImpl{
+		value: value,
+	}, nil
+}
+
+func (w writeImpl) HasField(name string) bool {
+	return NewReader(w.value).HasField(name)
+}
+
+func (w writeImpl) SetField(name string, value interface{}) error {
+	segments, err := parsePath(name)
+	if err != nil {
+		return fmt.Errorf("SetField: %w", err)
+	}
+
+	if err := w.set(reflect.ValueOf(w.value).Elem(), segments, value); err != nil {
+		return fmt.Errorf(`SetField: field "%s": %w`, name, err)
+	}
+
+	return nil
+}
+
+func (w writeImpl) set(target reflect.Value, segments []pathSegment, value interface{}) error {
+	if len(segments) == 0 {
+		return assignValue(target, value)
+	}
+
+	for target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			if !target.CanSet() {
+				return errors.New("nil pointer can't be allocated")
+			}
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+
+	segment, rest := segments[0], segments[1:]
+
+	if !segment.isIndex {
+		if target.Kind() != reflect.Struct {
+			return fmt.Errorf(`"%s" is not a field of %s`, segment.name, target.Type())
+		}
+
+		field, ok := target.Type().FieldByName(segment.name)
+		if !ok {
+			return fmt.Errorf(`"%s" is not a field of %s`, segment.name, target.Type())
+		}
+
+		for i, index := range field.Index {
+			if i > 0 {
+				for target.Kind() == reflect.Ptr {
+					if target.IsNil() {
+						if !target.CanSet() {
+							return errors.New("nil pointer can't be allocated")
+						}
+						target.Set(reflect.New(target.Type().Elem()))
+					}
+					target = target.Elem()
+				}
+			}
+			target = target.Field(index)
+		}
+
+		if !target.CanSet() {
+			return fmt.Errorf(`field "%s" can't be set`, segment.name)
+		}
+
+		return w.set(target, rest, value)
+	}
+
+	switch target.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, err := strconv.Atoi(segment.name)
+		if err != nil || index < 0 || index >= target.Len() {
+			return fmt.Errorf("index [%s] is out of range", segment.name)
+		}
+		return w.set(target.Index(index), rest, value)
+	case reflect.Map:
+		key, err := parsePathKey(segment.name, target.Type().Key())
+		if err != nil {
+			return fmt.Errorf("invalid key [%s]: %w", segment.name, err)
+		}
+
+		if target.IsNil() {
+			if !target.CanSet() {
+				return errors.New("nil map can't be allocated")
+			}
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+
+		element := reflect.New(target.Type().Elem()).Elem()
+		if existing := target.MapIndex(key); existing.IsValid() {
+			element.Set(existing)
+		}
+
+		if err := w.set(element, rest, value); err != nil {
+			return err
+		}
+
+		target.SetMapIndex(key, element)
+		return nil
+	default:
+		return fmt.Errorf("index [%s] can't be used on %s", segment.name, target.Type())
+	}
+}
+
+func (w writeImpl) SetInt(name string, value int) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetInt8(name string, value int8) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetInt16(name string, value int16) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetInt32(name string, value int32) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetInt64(name string, value int64) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetUint(name string, value uint) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetUint8(name string, value uint8) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetUint16(name string, value uint16) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetUint32(name string, value uint32) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetUint64(name string, value uint64) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetFloat32(name string, value float32) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetFloat64(name string, value float64) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetString(name string, value string) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetBool(name string, value bool) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) SetTime(name string, value time.Time) error {
+	return w.SetField(name, value)
+}
+
+func (w writeImpl) GetValue() interface{} {
+	return w.value
+}
+
+func assignValue(target reflect.Value, value interface{}) error {
+	if value == nil {
+		switch target.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		default:
+			return fmt.Errorf("nil can't be assigned to %s", target.Type())
+		}
+	}
+
+	converted, err := convertValue(reflect.ValueOf(value), target.Type())
+	if err == nil {
+		target.Set(converted)
+		return nil
+	}
+
+	if target.Kind() == reflect.Ptr {
+		if elem, elemErr := convertValue(reflect.ValueOf(value), target.Type().Elem()); elemErr == nil {
+			pointer := reflect.New(target.Type().Elem())
+			pointer.Elem().Set(elem)
+			target.Set(pointer)
+			return nil
+		}
+	}
+
+	return err
+}
+
+func convertValue(value reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if value.Type().AssignableTo(typ) {
+		return value, nil
+	}
+
+	if isNumberKind(value.Kind()) && isNumberKind(typ.Kind()) {
+		converted := value.Convert(typ)
+		if converted.Convert(value.Type()).Interface() != value.Interface() || isNegative(converted) != isNegative(value) {
+			return reflect.Value{}, fmt.Errorf("%v can't be converted to %s without loss", value.Interface(), typ)
+		}
+		return converted, nil
+	}
+
+	if value.Kind() == typ.Kind() && value.Type().ConvertibleTo(typ) {
+		return value.Convert(typ), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("%s can't be assigned to %s", value.Type(), typ)
+}
@@ -0,0 +1,185 @@
+package dynamicstruct
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewWriter(t *testing.T) {
+	if _, err := NewWriter(&testStructOne{}); err != nil {
+		t.Errorf(`TestNewWriter - expected not to have error got %#v`, err)
+	}
+
+	var nilPointer *testStructOne
+	for _, value := range []interface{}{testStructOne{}, nilPointer, new(int), nil} {
+		if _, err := NewWriter(value); err == nil {
+			t.Errorf(`TestNewWriter - expected error for %#v`, value)
+		}
+	}
+}
+
+func TestWriterImpl_SetField(t *testing.T) {
+	type Embedded struct {
+		String string
+	}
+
+	type Nested struct {
+		*Embedded
+		Pointer *testStructOne
+		Items   []testStructOne
+		Lookup  map[string]testStructOne
+		Counts  map[int]*int
+		Number  int8
+		private int
+	}
+
+	instance := NewStruct().
+		AddField("Nested", Nested{}, "").
+		AddField("Pointer", (*Nested)(nil), "").
+		Build().
+		New()
+
+	writer, err := NewWriter(instance)
+	if err != nil {
+		t.Fatalf(`TestWriterImpl_SetField - expected not to have error got %#v`, err)
+	}
+
+	str := "text"
+	now := time.Now()
+
+	values := []struct {
+		name  string
+		value interface{}
+	}{
+		{"Nested.String", "promoted"},
+		{"Nested.Pointer.PointerString", &str},
+		{"Nested.Pointer.PointerTime", now},
+		{"Nested.Pointer.Float", int32(5)},
+		{"Nested.Lookup[key].Integers", []int{1, 2}},
+		{"Nested.Counts[3]", uint8(7)},
+		{"Nested.Number", int64(-100)},
+		{"Pointer.Pointer.Uinteger", 42},
+		{"Pointer.Items", []testStructOne{{}, {}}},
+		{"Pointer.Items[1].PointerInteger", 10},
+		{"Pointer.Items[1].PointerUinteger", nil},
+	}
+
+	for _, value := range values {
+		if err := writer.SetField(value.name, value.value); err != nil {
+			t.Errorf(`TestWriterImpl_SetField - expected not to have error for "%s" got %#v`, value.name, err)
+		}
+	}
+
+	reader := NewReader(instance)
+
+	if value := reader.GetField("Nested.String").String(); value != "promoted" {
+		t.Errorf(`TestWriterImpl_SetField - expected "promoted" got %#v`, value)
+	}
+	if value := reader.GetField("Nested.Pointer.PointerString").Interface(); value != &str {
+		t.Errorf(`TestWriterImpl_SetField - expected pointer %#v got %#v`, &str, value)
+	}
+	if value := reader.GetField("Nested.Pointer.PointerTime").Time(); !value.Equal(now) {
+		t.Errorf(`TestWriterImpl_SetField - expected %#v got %#v`, now, value)
+	}
+	if value := reader.GetField("Nested.Pointer.Float").Float64(); value != 5 {
+		t.Errorf(`TestWriterImpl_SetField - expected 5 got %#v`, value)
+	}
+	if value := reader.GetField("Nested.Lookup[key].Integers").Interface(); !reflect.DeepEqual(value, []int{1, 2}) {
+		t.Errorf(`TestWriterImpl_SetField - expected []int{1, 2} got %#v`, value)
+	}
+	if value := reader.GetField("Nested.Counts[3]").Int(); value != 7 {
+		t.Errorf(`TestWriterImpl_SetField - expected 7 got %#v`, value)
+	}
+	if value := reader.GetField("Nested.Number").Int8(); value != -100 {
+		t.Errorf(`TestWriterImpl_SetField - expected -100 got %#v`, value)
+	}
+	if value := reader.GetField("Pointer.Pointer.Uinteger").Uint(); value != 42 {
+		t.Errorf(`TestWriterImpl_SetField - expected 42 got %#v`, value)
+	}
+	if value := reader.GetField("Pointer.Items[1].PointerInteger").Int(); value != 10 {
+		t.Errorf(`TestWriterImpl_SetField - expected 10 got %#v`, value)
+	}
+
+	invalid := map[string]interface{}{
+		"Unknown":                   1,
+		"Nested.Unknown":            1,
+		"Nested.Number":             1000,
+		"Nested.Pointer.Uinteger":   -1,
+		"Nested.Pointer.Integer":    1.5,
+		"Nested.Pointer.String":     1,
+		"Nested.Pointer.Integer[0]": 1,
+		"Nested.Items[5]":           testStructOne{},
+		"Nested.Counts[x]":          1,
+		"Nested.private":            1,
+		"Nested.Pointer.Bool":       nil,
+		"Nested..Bool":              true,
+	}
+
+	for name, value := range invalid {
+		if err := writer.SetField(name, value); err == nil {
+			t.Errorf(`TestWriterImpl_SetField - expected error for "%s" and %#v`, name, value)
+		}
+	}
+}
+
+func TestWriterImpl_TypedSetters(t *testing.T) {
+	type Numbers struct {
+		Int     int
+		Int8    int8
+		Int16   int16
+		Int32   int32
+		Int64   int64
+		Uint    uint
+		Uint8   uint8
+		Uint16  uint16
+		Uint32  uint32
+		Uint64  uint64
+		Float32 float32
+		Float64 float64
+		String  *string
+		Bool    bool
+		Time    time.Time
+	}
+
+	var instance Numbers
+	writer, _ := NewWriter(&instance)
+
+	now := time.Now()
+	errs := []error{
+		writer.SetInt("Int", 1),
+		writer.SetInt8("Int8", 2),
+		writer.SetInt16("Int16", 3),
+		writer.SetInt32("Int32", 4),
+		writer.SetInt64("Int64", 5),
+		writer.SetUint("Uint", 6),
+		writer.SetUint8("Uint8", 7),
+		writer.SetUint16("Uint16", 8),
+		writer.SetUint32("Uint32", 9),
+		writer.SetUint64("Uint64", 10),
+		writer.SetFloat32("Float32", 11.5),
+		writer.SetFloat64("Float64", 12.5),
+		writer.SetString("String", "text"),
+		writer.SetBool("Bool", true),
+		writer.SetTime("Time", now),
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			t.Errorf(`TestWriterImpl_TypedSetters - expected not to have error got %#v`, err)
+		}
+	}
+
+	text := "text"
+	expected := Numbers{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11.5, 12.5, &text, true, now}
+	if !reflect.DeepEqual(instance, expected) {
+		t.Errorf(`TestWriterImpl_TypedSetters - expected %#v got %#v`, expected, instance)
+	}
+
+	if writer.GetValue() != &instance {
+		t.Errorf(`TestWriterImpl_TypedSetters - expected original value got %#v`, writer.GetValue())
+	}
+	if !writer.HasField("Time") || writer.HasField("Unknown") {
+		t.Error(`TestWriterImpl_TypedSetters - expected to have field "Time" only`)
+	}
+}